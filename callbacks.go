@@ -19,6 +19,7 @@ func initializeCallbacks(db *DB) *callbacks {
 			"query":  {db: db},
 			"update": {db: db},
 			"delete": {db: db},
+			"merge":  {db: db},
 			"row":    {db: db},
 			"raw":    {db: db},
 		},
@@ -64,6 +65,10 @@ func (cs *callbacks) Delete() *processor {
 	return cs.processors["delete"]
 }
 
+func (cs *callbacks) Merge() *processor {
+	return cs.processors["merge"]
+}
+
 func (cs *callbacks) Row() *processor {
 	return cs.processors["row"]
 }
@@ -3,13 +3,31 @@ package callbacks
 
 import (
 	"gorm.io/gorm"
+	"gorm.io/gorm/utils"
 )
 
 var (
 	createClauses = []string{"INSERT", "VALUES", "ON CONFLICT"}
-	queryClauses  = []string{"SELECT", "FROM", "WHERE", "GROUP BY", "ORDER BY", "LIMIT", "FOR"}
-	updateClauses = []string{"UPDATE", "SET", "WHERE"}
-	deleteClauses = []string{"DELETE", "FROM", "WHERE"}
+	queryClauses  = []string{"WITH", "SELECT", "FROM", "WHERE", "GROUP BY", "WINDOW", "SET OPERATIONS", "ORDER BY", "LIMIT", "FOR"}
+	updateClauses = []string{"WITH", "UPDATE", "SET", "WHERE"}
+	deleteClauses = []string{"WITH", "DELETE", "FROM", "WHERE"}
+	mergeClauses  = []string{"WITH", "MERGE"}
+)
+
+// JoinStyle the syntax restricting the updated or deleted rows with the joined tables
+type JoinStyle int
+
+const (
+	// SubqueryJoinStyle selects the primary keys of the rows with the joins in a subquery, which works for all dialects,
+	// like `UPDATE users SET ... WHERE users.id IN (SELECT * FROM (SELECT users.id FROM users JOIN ...) AS joined_users)`
+	SubqueryJoinStyle JoinStyle = iota
+	// FromJoinStyle lists the joined tables in the FROM clause of update and the USING clause of delete, like
+	// `UPDATE users SET ... FROM companies WHERE ...`, the joins which couldn't be listed as tables, like left joins, are
+	// still moved into a subquery
+	FromJoinStyle
+	// MultiTableJoinStyle joins the tables after the updated or deleted table, like
+	// `UPDATE users JOIN companies ON ... SET ...` and `DELETE users FROM users JOIN companies ON ...`
+	MultiTableJoinStyle
 )
 
 type Config struct {
@@ -18,6 +36,9 @@ type Config struct {
 	QueryClauses         []string
 	UpdateClauses        []string
 	DeleteClauses        []string
+	MergeClauses         []string
+	UpdateJoinStyle      JoinStyle
+	DeleteJoinStyle      JoinStyle
 }
 
 func RegisterDefaultCallbacks(db *gorm.DB, config *Config) {
@@ -37,6 +58,55 @@ func RegisterDefaultCallbacks(db *gorm.DB, config *Config) {
 	if len(config.UpdateClauses) == 0 {
 		config.UpdateClauses = updateClauses
 	}
+	if len(config.MergeClauses) == 0 {
+		config.MergeClauses = mergeClauses
+	}
+
+	// common table expressions are supported by select, update and delete statements
+	for _, clauses := range []*[]string{&config.QueryClauses, &config.UpdateClauses, &config.DeleteClauses} {
+		if !utils.Contains(*clauses, "WITH") {
+			*clauses = append([]string{"WITH"}, *clauses...)
+		}
+	}
+
+	// the joined tables are listed in the FROM clause of update and the USING clause of delete, like
+	// `UPDATE users SET ... FROM companies WHERE ...` and `DELETE FROM users USING companies WHERE ...`
+	if config.UpdateJoinStyle == FromJoinStyle && !utils.Contains(config.UpdateClauses, "FROM") {
+		config.UpdateClauses = insertClauseBefore(config.UpdateClauses, "FROM", "WHERE")
+	}
+	if config.DeleteJoinStyle == FromJoinStyle && !utils.Contains(config.DeleteClauses, "USING") {
+		config.DeleteClauses = insertClauseBefore(config.DeleteClauses, "USING", "WHERE")
+	}
+
+	// named windows are defined after GROUP BY
+	if !utils.Contains(config.QueryClauses, "WINDOW") {
+		clauses := make([]string, 0, len(config.QueryClauses)+1)
+		for _, name := range config.QueryClauses {
+			clauses = append(clauses, name)
+			if name == "GROUP BY" {
+				clauses = append(clauses, "WINDOW")
+			}
+		}
+		if len(clauses) == len(config.QueryClauses) {
+			clauses = append(clauses, "WINDOW")
+		}
+		config.QueryClauses = clauses
+	}
+
+	// set operations combine the query results before ORDER BY and LIMIT
+	if !utils.Contains(config.QueryClauses, "SET OPERATIONS") {
+		clauses := make([]string, 0, len(config.QueryClauses)+1)
+		for _, name := range config.QueryClauses {
+			if name == "ORDER BY" {
+				clauses = append(clauses, "SET OPERATIONS")
+			}
+			clauses = append(clauses, name)
+		}
+		if len(clauses) == len(config.QueryClauses) {
+			clauses = append(clauses, "SET OPERATIONS")
+		}
+		config.QueryClauses = clauses
+	}
 
 	createCallback := db.Callback().Create()
 	createCallback.Match(enableTransaction).Register("gorm:begin_transaction", BeginTransaction)
@@ -74,6 +144,10 @@ func RegisterDefaultCallbacks(db *gorm.DB, config *Config) {
 	updateCallback.Match(enableTransaction).Register("gorm:commit_or_rollback_transaction", CommitOrRollbackTransaction)
 	updateCallback.Clauses = config.UpdateClauses
 
+	mergeCallback := db.Callback().Merge()
+	mergeCallback.Register("gorm:merge", Merge)
+	mergeCallback.Clauses = config.MergeClauses
+
 	rowCallback := db.Callback().Row()
 	rowCallback.Register("gorm:row", RowQuery)
 	rowCallback.Clauses = config.QueryClauses
@@ -82,3 +156,18 @@ func RegisterDefaultCallbacks(db *gorm.DB, config *Config) {
 	rawCallback.Register("gorm:raw", RawExec)
 	rawCallback.Clauses = config.QueryClauses
 }
+
+// insertClauseBefore inserts the clause name before the clause before, or appends it if not found
+func insertClauseBefore(clauses []string, name, before string) []string {
+	inserted := make([]string, 0, len(clauses)+1)
+	for _, c := range clauses {
+		if c == before {
+			inserted = append(inserted, name)
+		}
+		inserted = append(inserted, c)
+	}
+	if len(inserted) == len(clauses) {
+		inserted = append(inserted, name)
+	}
+	return inserted
+}
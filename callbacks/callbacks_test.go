@@ -0,0 +1,71 @@
+package callbacks_test
+
+import (
+	"reflect"
+	"testing"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/callbacks"
+	"gorm.io/gorm/utils/tests"
+)
+
+type queryClausesDialector struct {
+	tests.DummyDialector
+	queryClauses []string
+}
+
+func (dialector queryClausesDialector) Initialize(db *gorm.DB) error {
+	callbacks.RegisterDefaultCallbacks(db, &callbacks.Config{QueryClauses: dialector.queryClauses})
+	return nil
+}
+
+func TestRegisterDefaultCallbacksInjectQueryClauses(t *testing.T) {
+	db, err := gorm.Open(queryClausesDialector{queryClauses: []string{"SELECT", "FROM", "WHERE", "GROUP BY", "ORDER BY", "LIMIT", "FOR"}})
+	if err != nil {
+		t.Fatalf("failed to open db, got error %v", err)
+	}
+
+	expects := []string{"WITH", "SELECT", "FROM", "WHERE", "GROUP BY", "WINDOW", "SET OPERATIONS", "ORDER BY", "LIMIT", "FOR"}
+	if clauses := db.Callback().Query().Clauses; !reflect.DeepEqual(clauses, expects) {
+		t.Errorf("expects query clauses %v, got %v", expects, clauses)
+	}
+}
+
+type joinStyleDialector struct {
+	tests.DummyDialector
+	config *callbacks.Config
+}
+
+func (dialector joinStyleDialector) Initialize(db *gorm.DB) error {
+	callbacks.RegisterDefaultCallbacks(db, dialector.config)
+	return nil
+}
+
+func TestRegisterDefaultCallbacksInjectJoinClauses(t *testing.T) {
+	db, err := gorm.Open(joinStyleDialector{config: &callbacks.Config{
+		UpdateClauses:   []string{"UPDATE", "SET", "WHERE", "RETURNING"},
+		DeleteClauses:   []string{"DELETE", "FROM", "WHERE", "RETURNING"},
+		UpdateJoinStyle: callbacks.FromJoinStyle,
+		DeleteJoinStyle: callbacks.FromJoinStyle,
+	}})
+	if err != nil {
+		t.Fatalf("failed to open db, got error %v", err)
+	}
+
+	if expects, clauses := []string{"WITH", "UPDATE", "SET", "FROM", "WHERE", "RETURNING"}, db.Callback().Update().Clauses; !reflect.DeepEqual(clauses, expects) {
+		t.Errorf("expects update clauses %v, got %v", expects, clauses)
+	}
+
+	if expects, clauses := []string{"WITH", "DELETE", "FROM", "USING", "WHERE", "RETURNING"}, db.Callback().Delete().Clauses; !reflect.DeepEqual(clauses, expects) {
+		t.Errorf("expects delete clauses %v, got %v", expects, clauses)
+	}
+
+	db, err = gorm.Open(joinStyleDialector{config: &callbacks.Config{DeleteJoinStyle: callbacks.MultiTableJoinStyle}})
+	if err != nil {
+		t.Fatalf("failed to open db, got error %v", err)
+	}
+
+	if expects, clauses := []string{"WITH", "DELETE", "FROM", "WHERE"}, db.Callback().Delete().Clauses; !reflect.DeepEqual(clauses, expects) {
+		t.Errorf("expects delete clauses %v, got %v", expects, clauses)
+	}
+}
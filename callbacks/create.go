@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -42,6 +43,10 @@ func Create(config *Config) func(db *gorm.DB) {
 			return
 		}
 
+		// create from query, e.g: INSERT ... SELECT
+		values, fromQuery := db.Statement.Clauses["VALUES"].Expression.(clause.Values)
+		fromQuery = fromQuery && values.Query != nil
+
 		if db.Statement.Schema != nil {
 			if !db.Statement.Unscoped {
 				for _, c := range db.Statement.Schema.CreateClauses {
@@ -49,7 +54,7 @@ func Create(config *Config) func(db *gorm.DB) {
 				}
 			}
 
-			if supportReturning && len(db.Statement.Schema.FieldsWithDefaultDBValue) > 0 {
+			if supportReturning && !fromQuery && len(db.Statement.Schema.FieldsWithDefaultDBValue) > 0 {
 				if _, ok := db.Statement.Clauses["RETURNING"]; !ok {
 					fromColumns := make([]clause.Column, 0, len(db.Statement.Schema.FieldsWithDefaultDBValue))
 					for _, field := range db.Statement.Schema.FieldsWithDefaultDBValue {
@@ -67,9 +72,16 @@ func Create(config *Config) func(db *gorm.DB) {
 		if db.Statement.SQL.Len() == 0 {
 			db.Statement.SQL.Grow(180)
 			db.Statement.AddClauseIfNotExists(clause.Insert{})
-			db.Statement.AddClause(ConvertToCreateValues(db.Statement))
+			if fromQuery {
+				setupOnConflictUpdateAll(db.Statement, values, db.Statement.DB.NowFunc())
+			} else {
+				createValues := ConvertToCreateValues(db.Statement)
+				checkEnumValues(db.Statement, createValues.Columns, createValues.Values...)
+				db.Statement.AddClause(createValues)
+			}
 
 			db.Statement.Build(db.Statement.BuildClauses...)
+			db.Statement.BuildComment()
 		}
 
 		isDryRun := !db.DryRun && db.Error == nil
@@ -120,7 +132,7 @@ func Create(config *Config) func(db *gorm.DB) {
 			db.Statement.Result.RowsAffected = db.RowsAffected
 		}
 
-		if db.RowsAffected == 0 {
+		if db.RowsAffected == 0 || fromQuery {
 			return
 		}
 
@@ -364,6 +376,12 @@ func ConvertToCreateValues(stmt *gorm.Statement) (values clause.Values) {
 		}
 	}
 
+	setupOnConflictUpdateAll(stmt, values, curTime)
+	return values
+}
+
+// setupOnConflictUpdateAll assigns all inserted columns for OnConflict with UpdateAll
+func setupOnConflictUpdateAll(stmt *gorm.Statement, values clause.Values, curTime time.Time) {
 	if c, ok := stmt.Clauses["ON CONFLICT"]; ok {
 		if onConflict, _ := c.Expression.(clause.OnConflict); onConflict.UpdateAll {
 			if stmt.Schema != nil && len(values.Columns) >= 1 {
@@ -411,5 +429,4 @@ func ConvertToCreateValues(stmt *gorm.Statement) (values clause.Values) {
 		}
 	}
 
-	return values
 }
@@ -118,6 +118,13 @@ func Delete(config *Config) func(db *gorm.DB) {
 			return
 		}
 
+		if len(db.Statement.Joins) > 0 && db.Statement.SQL.Len() == 0 {
+			deleteWithJoins(db, config.DeleteJoinStyle)
+			if db.Error != nil {
+				return
+			}
+		}
+
 		if db.Statement.Schema != nil {
 			for _, c := range db.Statement.Schema.DeleteClauses {
 				db.Statement.AddClause(c)
@@ -149,6 +156,7 @@ func Delete(config *Config) func(db *gorm.DB) {
 			db.Statement.AddClauseIfNotExists(clause.From{})
 
 			db.Statement.Build(db.Statement.BuildClauses...)
+			db.Statement.BuildComment()
 		}
 
 		checkMissingWhereConditions(db)
@@ -187,6 +195,51 @@ func Delete(config *Config) func(db *gorm.DB) {
 	}
 }
 
+// deleteWithJoins restricts the deleted rows with the joined tables in the join style of the dialect, the joins are
+// moved into a subquery if the statement will be modified as an update statement, e.g: soft delete
+//
+//	DELETE FROM users USING companies WHERE ...
+//	DELETE users FROM users JOIN companies ON ... WHERE ...
+//	DELETE FROM users WHERE users.id IN (SELECT * FROM (SELECT users.id FROM users JOIN ...) AS joined_users)
+func deleteWithJoins(db *gorm.DB, style JoinStyle) {
+	joins := joinClauses(db)
+
+	if db.Statement.Schema != nil && !db.Statement.Unscoped {
+		for _, c := range db.Statement.Schema.DeleteClauses {
+			if _, ok := c.(gorm.StatementModifier); ok {
+				style = SubqueryJoinStyle
+				break
+			}
+		}
+	}
+
+	switch style {
+	case FromJoinStyle:
+		if tables, conds, ok := joinTables(joins); ok {
+			db.Statement.AddClause(clause.Using{Tables: tables})
+			if len(conds) > 0 {
+				db.Statement.AddClause(clause.Where{Exprs: conds})
+			}
+			return
+		}
+	case MultiTableJoinStyle:
+		deleteClause := clause.Delete{}
+		if c, ok := db.Statement.Clauses["DELETE"]; ok {
+			deleteClause, _ = c.Expression.(clause.Delete)
+		}
+		deleteClause.Tables = []clause.Table{{Name: clause.CurrentTable}}
+		db.Statement.AddClause(deleteClause)
+		db.Statement.AddClause(clause.From{Joins: joins})
+		return
+	}
+
+	cond, err := joinSubqueryCondition(db, joins)
+	if db.AddError(err) != nil {
+		return
+	}
+	db.Statement.AddClause(clause.Where{Exprs: []clause.Expression{cond}})
+}
+
 func AfterDelete(db *gorm.DB) {
 	if db.Error == nil && db.Statement.Schema != nil && !db.Statement.SkipHooks && db.Statement.Schema.AfterDelete {
 		callMethod(db, func(value interface{}, tx *gorm.DB) bool {
@@ -1,11 +1,16 @@
 package callbacks
 
 import (
+	"fmt"
 	"reflect"
+	"regexp"
 	"sort"
+	"strings"
 
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+	"gorm.io/gorm/utils"
 )
 
 // ConvertMapToValuesForCreate convert map to values
@@ -110,18 +115,207 @@ func checkMissingWhereConditions(db *gorm.DB) {
 	if !db.AllowGlobalUpdate && db.Error == nil {
 		where, withCondition := db.Statement.Clauses["WHERE"]
 		if withCondition {
-			if _, withSoftDelete := db.Statement.Clauses["soft_delete_enabled"]; withSoftDelete {
-				whereClause, _ := where.Expression.(clause.Where)
-				withCondition = len(whereClause.Exprs) > 1
+			whereClause, _ := where.Expression.(clause.Where)
+			conditions := len(whereClause.Exprs)
+			_, withSoftDelete := db.Statement.Clauses["soft_delete_enabled"]
+			for _, expr := range whereClause.Exprs {
+				if cond, ok := expr.(joinCondition); ok {
+					if !cond.restricted {
+						conditions--
+					}
+					withSoftDelete = withSoftDelete && !cond.softDelete
+				}
+			}
+
+			if withSoftDelete {
+				withCondition = conditions > 1
+			} else if conditions < len(whereClause.Exprs) {
+				withCondition = conditions > 0
 			}
 		}
-		if !withCondition {
+
+		if !withCondition && !restrictedByJoins(db) {
 			db.AddError(gorm.ErrMissingWhereClause)
 		}
 		return
 	}
 }
 
+// restrictedByJoins reports whether the rows are restricted by inner joins with conditions, which are treated as
+// where conditions, left joins or cross joins don't restrict the rows
+func restrictedByJoins(db *gorm.DB) bool {
+	for _, join := range db.Statement.Joins {
+		switch expr := join.Expression.(type) {
+		case nil:
+			// inner joins of relations are joined on the foreign keys
+			if db.Statement.Schema != nil {
+				if _, ok := joinRelations(db.Statement.Schema, join.Name); ok {
+					if join.JoinType == clause.InnerJoin {
+						return true
+					}
+					continue
+				}
+			}
+
+			if matches := rawJoinRegexp.FindStringSubmatch(join.Name); len(matches) > 0 && matches[2] != "" &&
+				!strings.HasPrefix(strings.ToUpper(strings.TrimSpace(join.Name)), "CROSS") {
+				return true
+			}
+		case clause.Join:
+			if expr.Type == clause.InnerJoin && len(expr.ON.Exprs) > 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// joinCondition the condition restricting the rows with the joins in a subquery, it is only counted as a where
+// condition if the where conditions moved into the subquery are not empty, besides the soft delete condition
+type joinCondition struct {
+	clause.Expression
+	restricted bool
+	softDelete bool // the soft delete condition is moved into the subquery
+}
+
+// joinRelations returns the relations of the join name, nested join like "Manager.Company" is only treated as
+// relations when all of the names are matched
+func joinRelations(s *schema.Schema, name string) ([]*schema.Relationship, bool) {
+	if relation, ok := s.Relationships.Relations[name]; ok {
+		return []*schema.Relationship{relation}, true
+	}
+
+	nestedJoinNames := strings.Split(name, ".")
+	if len(nestedJoinNames) > 1 {
+		relations := make([]*schema.Relationship, 0, len(nestedJoinNames))
+		currentRelations := s.Relationships.Relations
+		for _, relname := range nestedJoinNames {
+			relation, ok := currentRelations[relname]
+			if !ok {
+				return nil, false
+			}
+			relations = append(relations, relation)
+			currentRelations = relation.FieldSchema.Relationships.Relations
+		}
+		return relations, true
+	}
+
+	return nil, false
+}
+
+// joinClauses converts the joins of update and delete statements to join clauses, joins of relations keep their join
+// type, e.g: left joins of `Joins("Company")` and inner joins of `InnerJoins("Company")`
+func joinClauses(db *gorm.DB) (joins []clause.Join) {
+	joinedTables := map[string]bool{}
+	for _, join := range db.Statement.Joins {
+		if join.Expression != nil {
+			joins = append(joins, clause.Join{Type: join.JoinType, Expression: join.Expression})
+			continue
+		}
+
+		if db.Statement.Schema != nil {
+			if relations, ok := joinRelations(db.Statement.Schema, join.Name); ok {
+				parentTableName := clause.CurrentTable
+				for idx, rel := range relations {
+					aliasName := rel.Name
+					if parentTableName != clause.CurrentTable {
+						aliasName = utils.NestedRelationName(parentTableName, aliasName)
+					}
+
+					var on *clause.Where
+					if idx == len(relations)-1 {
+						on = join.On
+						if join.Alias != "" {
+							aliasName = join.Alias
+						}
+					}
+
+					if !joinedTables[aliasName] {
+						joins = append(joins, relationJoinClause(db, join.JoinType, aliasName, parentTableName, rel, on))
+						joinedTables[aliasName] = true
+					}
+					parentTableName = aliasName
+				}
+				continue
+			}
+		}
+
+		joins = append(joins, clause.Join{Expression: clause.NamedExpr{SQL: join.Name, Vars: join.Conds}})
+	}
+	return
+}
+
+var rawJoinRegexp = regexp.MustCompile(`(?is)^\s*(?:(?:INNER|CROSS)\s+)?JOIN\s+(.+?)(?:\s+ON\s+(.+))?\s*$`)
+
+// joinTables splits the inner join clauses into the joined tables and the join conditions, used by the dialects which
+// update from or delete using other tables like `UPDATE users SET ... FROM companies WHERE ...`, returns false if any
+// join couldn't be listed as a table, like left joins or joins with USING
+func joinTables(joins []clause.Join) (tables []clause.Table, conds []clause.Expression, ok bool) {
+	for _, join := range joins {
+		switch expr := join.Expression.(type) {
+		case nil:
+			if (join.Type != "" && join.Type != clause.InnerJoin && join.Type != clause.CrossJoin) || len(join.Using) > 0 ||
+				join.Subquery != nil {
+				return nil, nil, false
+			}
+			tables = append(tables, join.Table)
+			conds = append(conds, join.ON.Exprs...)
+		case clause.NamedExpr:
+			matches := rawJoinRegexp.FindStringSubmatch(expr.SQL)
+			if len(matches) == 0 {
+				return nil, nil, false
+			}
+
+			tables = append(tables, clause.Table{Name: matches[1], Raw: true})
+			if matches[2] != "" {
+				conds = append(conds, clause.NamedExpr{SQL: matches[2], Vars: expr.Vars})
+			}
+		default:
+			return nil, nil, false
+		}
+	}
+	return tables, conds, true
+}
+
+// joinSubqueryCondition moves the where conditions into a subquery with the joins, the rows are restricted by their
+// primary keys returned by the subquery, like `WHERE users.id IN (SELECT * FROM (SELECT users.id FROM users JOIN ...) AS ...)`,
+// the subquery is wrapped with a derived table as some databases can't select from the updating table
+func joinSubqueryCondition(db *gorm.DB, joins []clause.Join) (clause.Expression, error) {
+	if db.Statement.Schema == nil || len(db.Statement.Schema.PrimaryFieldDBNames) == 0 {
+		return nil, fmt.Errorf("%w when joining tables", gorm.ErrPrimaryKeyRequired)
+	}
+
+	primaryKeys := make([]interface{}, len(db.Statement.Schema.PrimaryFieldDBNames))
+	selectColumns := make([]clause.Column, len(db.Statement.Schema.PrimaryFieldDBNames))
+	for idx, dbName := range db.Statement.Schema.PrimaryFieldDBNames {
+		selectColumns[idx] = clause.Column{Table: clause.CurrentTable, Name: dbName}
+		primaryKeys[idx] = selectColumns[idx]
+	}
+
+	cond := joinCondition{}
+	subquery := clause.Expr{SQL: "? ?", Vars: []interface{}{clause.Select{Columns: selectColumns}, clause.From{Joins: joins}}}
+	if c, ok := db.Statement.Clauses["WHERE"]; ok {
+		if where, ok := c.Expression.(clause.Where); ok && len(where.Exprs) > 0 {
+			subquery.SQL += " ?"
+			subquery.Vars = append(subquery.Vars, where)
+			_, cond.softDelete = db.Statement.Clauses["soft_delete_enabled"]
+			cond.restricted = len(where.Exprs) > 1 || !cond.softDelete
+		}
+		delete(db.Statement.Clauses, "WHERE")
+	}
+
+	var column interface{} = primaryKeys
+	if len(primaryKeys) == 1 {
+		column = primaryKeys[0]
+	}
+
+	cond.Expression = clause.Expr{
+		SQL:  "? IN (SELECT * FROM (?) AS ?)",
+		Vars: []interface{}{column, subquery, clause.Table{Name: "joined_" + db.Statement.Table}},
+	}
+	return cond, nil
+}
+
 type visitMap = map[reflect.Value]bool
 
 // Check if circular values, return true if loaded
@@ -150,3 +344,23 @@ func loadOrStoreVisitMap(visitMap *visitMap, v reflect.Value) (loaded bool) {
 
 	return
 }
+
+// checkEnumValues checks the values of enum fields in Go before executing, reports ErrInvalidEnumValue if the value
+// isn't one of the enum values
+func checkEnumValues(stmt *gorm.Statement, columns []clause.Column, values ...[]interface{}) {
+	if stmt.Schema == nil {
+		return
+	}
+
+	for idx, column := range columns {
+		if field := stmt.Schema.LookUpField(column.Name); field != nil && len(field.EnumValues) > 0 {
+			for _, vs := range values {
+				if idx < len(vs) && !field.ValidEnumValue(vs[idx]) {
+					stmt.AddError(fmt.Errorf("%w: %v for field %s, should be one of %v", gorm.ErrInvalidEnumValue,
+						reflect.Indirect(reflect.ValueOf(vs[idx])).Interface(), field.Name, field.EnumValues))
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package callbacks
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// Merge merges the source into the table of the model, the dialect declares its support by registering the "MERGE"
+// clause builder, e.g: gorm.PostgresClauseBuilders
+func Merge(db *gorm.DB) {
+	if db.Error != nil {
+		return
+	}
+
+	if _, ok := db.ClauseBuilders["MERGE"]; !ok {
+		db.AddError(fmt.Errorf("%w: merge is not supported by %s", gorm.ErrUnsupportedDriver, db.Dialector.Name()))
+		return
+	}
+
+	if db.Statement.SQL.Len() == 0 {
+		db.Statement.SQL.Grow(180)
+		db.Statement.Build(db.Statement.BuildClauses...)
+		db.Statement.BuildComment()
+	}
+
+	RawExec(db)
+}
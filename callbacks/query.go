@@ -117,37 +117,7 @@ func BuildQuerySQL(db *gorm.DB) {
 			specifiedRelationsName := map[string]string{clause.CurrentTable: clause.CurrentTable}
 			for _, join := range db.Statement.Joins {
 				if db.Statement.Schema != nil {
-					var isRelations bool // is relations or raw sql
-					var relations []*schema.Relationship
-					relation, ok := db.Statement.Schema.Relationships.Relations[join.Name]
-					if ok {
-						isRelations = true
-						relations = append(relations, relation)
-					} else {
-						// handle nested join like "Manager.Company"
-						nestedJoinNames := strings.Split(join.Name, ".")
-						if len(nestedJoinNames) > 1 {
-							isNestedJoin := true
-							guessNestedRelations := make([]*schema.Relationship, 0, len(nestedJoinNames))
-							currentRelations := db.Statement.Schema.Relationships.Relations
-							for _, relname := range nestedJoinNames {
-								// incomplete match, only treated as raw sql
-								if relation, ok = currentRelations[relname]; ok {
-									guessNestedRelations = append(guessNestedRelations, relation)
-									currentRelations = relation.FieldSchema.Relationships.Relations
-								} else {
-									isNestedJoin = false
-									break
-								}
-							}
-
-							if isNestedJoin {
-								isRelations = true
-								relations = guessNestedRelations
-							}
-						}
-					}
-
+					relations, isRelations := joinRelations(db.Statement.Schema, join.Name) // is relations or raw sql
 					if isRelations {
 						genJoinClause := func(joinType clause.JoinType, tableAliasName string, parentTableName string, relation *schema.Relationship) clause.Join {
 							columnStmt := gorm.Statement{
@@ -173,62 +143,7 @@ func BuildQuerySQL(db *gorm.DB) {
 								}
 							}
 
-							exprs := make([]clause.Expression, len(relation.References))
-							for idx, ref := range relation.References {
-								if ref.OwnPrimaryKey {
-									exprs[idx] = clause.Eq{
-										Column: clause.Column{Table: parentTableName, Name: ref.PrimaryKey.DBName},
-										Value:  clause.Column{Table: tableAliasName, Name: ref.ForeignKey.DBName},
-									}
-								} else {
-									if ref.PrimaryValue == "" {
-										exprs[idx] = clause.Eq{
-											Column: clause.Column{Table: parentTableName, Name: ref.ForeignKey.DBName},
-											Value:  clause.Column{Table: tableAliasName, Name: ref.PrimaryKey.DBName},
-										}
-									} else {
-										exprs[idx] = clause.Eq{
-											Column: clause.Column{Table: tableAliasName, Name: ref.ForeignKey.DBName},
-											Value:  ref.PrimaryValue,
-										}
-									}
-								}
-							}
-
-							{
-								onStmt := gorm.Statement{Table: tableAliasName, DB: db, Clauses: map[string]clause.Clause{}}
-								for _, c := range relation.FieldSchema.QueryClauses {
-									onStmt.AddClause(c)
-								}
-
-								if join.On != nil {
-									onStmt.AddClause(join.On)
-								}
-
-								if cs, ok := onStmt.Clauses["WHERE"]; ok {
-									if where, ok := cs.Expression.(clause.Where); ok {
-										where.Build(&onStmt)
-
-										if onSQL := onStmt.SQL.String(); onSQL != "" {
-											vars := onStmt.Vars
-											for idx, v := range vars {
-												bindvar := strings.Builder{}
-												onStmt.Vars = vars[0 : idx+1]
-												db.Dialector.BindVarTo(&bindvar, &onStmt, v)
-												onSQL = strings.Replace(onSQL, bindvar.String(), "?", 1)
-											}
-
-											exprs = append(exprs, clause.Expr{SQL: onSQL, Vars: vars})
-										}
-									}
-								}
-							}
-
-							return clause.Join{
-								Type:  joinType,
-								Table: clause.Table{Name: relation.FieldSchema.Table, Alias: tableAliasName},
-								ON:    clause.Where{Exprs: exprs},
-							}
+							return relationJoinClause(db, joinType, tableAliasName, parentTableName, relation, join.On)
 						}
 
 						parentTableName := clause.CurrentTable
@@ -251,11 +166,17 @@ func BuildQuerySQL(db *gorm.DB) {
 
 							parentTableName = curAliasName
 						}
+					} else if join.Expression != nil {
+						fromClause.Joins = append(fromClause.Joins, clause.Join{Expression: join.Expression})
+						clauseSelect.Columns = append(clauseSelect.Columns, derivedTableColumns(db, join.Alias, join.Selects, join.Omits)...)
 					} else {
 						fromClause.Joins = append(fromClause.Joins, clause.Join{
 							Expression: clause.NamedExpr{SQL: join.Name, Vars: join.Conds},
 						})
 					}
+				} else if join.Expression != nil {
+					fromClause.Joins = append(fromClause.Joins, clause.Join{Expression: join.Expression})
+					clauseSelect.Columns = append(clauseSelect.Columns, derivedTableColumns(db, join.Alias, join.Selects, join.Omits)...)
 				} else {
 					fromClause.Joins = append(fromClause.Joins, clause.Join{
 						Expression: clause.NamedExpr{SQL: join.Name, Vars: join.Conds},
@@ -271,7 +192,93 @@ func BuildQuerySQL(db *gorm.DB) {
 		db.Statement.AddClauseIfNotExists(clauseSelect)
 
 		db.Statement.Build(db.Statement.BuildClauses...)
+		db.Statement.BuildComment()
+	}
+}
+
+// relationJoinClause returns the join clause of the relation with table alias, the ON conditions are built from
+// the references of the relation, the query clauses of the relation schema and the specified conditions
+func relationJoinClause(db *gorm.DB, joinType clause.JoinType, tableAliasName string, parentTableName string, relation *schema.Relationship, on *clause.Where) clause.Join {
+	exprs := make([]clause.Expression, len(relation.References))
+	for idx, ref := range relation.References {
+		if ref.OwnPrimaryKey {
+			exprs[idx] = clause.Eq{
+				Column: clause.Column{Table: parentTableName, Name: ref.PrimaryKey.DBName},
+				Value:  clause.Column{Table: tableAliasName, Name: ref.ForeignKey.DBName},
+			}
+		} else {
+			if ref.PrimaryValue == "" {
+				exprs[idx] = clause.Eq{
+					Column: clause.Column{Table: parentTableName, Name: ref.ForeignKey.DBName},
+					Value:  clause.Column{Table: tableAliasName, Name: ref.PrimaryKey.DBName},
+				}
+			} else {
+				exprs[idx] = clause.Eq{
+					Column: clause.Column{Table: tableAliasName, Name: ref.ForeignKey.DBName},
+					Value:  ref.PrimaryValue,
+				}
+			}
+		}
+	}
+
+	onStmt := gorm.Statement{Table: tableAliasName, DB: db, Clauses: map[string]clause.Clause{}}
+	for _, c := range relation.FieldSchema.QueryClauses {
+		onStmt.AddClause(c)
+	}
+
+	if on != nil {
+		onStmt.AddClause(on)
+	}
+
+	if cs, ok := onStmt.Clauses["WHERE"]; ok {
+		if where, ok := cs.Expression.(clause.Where); ok {
+			where.Build(&onStmt)
+
+			if onSQL := onStmt.SQL.String(); onSQL != "" {
+				vars := onStmt.Vars
+				for idx, v := range vars {
+					bindvar := strings.Builder{}
+					onStmt.Vars = vars[0 : idx+1]
+					db.Dialector.BindVarTo(&bindvar, &onStmt, v)
+					onSQL = strings.Replace(onSQL, bindvar.String(), "?", 1)
+				}
+
+				exprs = append(exprs, clause.Expr{SQL: onSQL, Vars: vars})
+			}
+		}
+	}
+
+	return clause.Join{
+		Type:  joinType,
+		Table: clause.Table{Name: relation.FieldSchema.Table, Alias: tableAliasName},
+		ON:    clause.Where{Exprs: exprs},
+	}
+}
+
+// derivedTableColumns returns the columns selected from the joined derived table, they are aliased like `alias__column`
+// to be scanned into the struct field named as the alias
+func derivedTableColumns(db *gorm.DB, alias string, selects, omits []string) (columns []clause.Column) {
+	if db.Statement.Schema != nil {
+		if field := db.Statement.Schema.FieldsByName[alias]; field != nil && field.IndirectFieldType.Kind() == reflect.Struct {
+			columnStmt := gorm.Statement{Table: alias, DB: db, Selects: selects, Omits: omits}
+			if err := columnStmt.Parse(reflect.New(field.IndirectFieldType).Interface()); err == nil {
+				selectColumns, restricted := columnStmt.SelectAndOmitColumns(false, false)
+				for _, s := range columnStmt.Schema.DBNames {
+					if v, ok := selectColumns[s]; (ok && v) || (!ok && !restricted) {
+						columns = append(columns, clause.Column{Table: alias, Name: s, Alias: utils.NestedRelationName(alias, s)})
+					}
+				}
+				return
+			}
+		}
+	}
+
+	for _, name := range selects {
+		if !utils.Contains(omits, name) {
+			columns = append(columns, clause.Column{Table: alias, Name: name, Alias: utils.NestedRelationName(alias, name)})
+		}
 	}
+	return
 }
 
 func Preload(db *gorm.DB) {
@@ -1,8 +1,10 @@
 package callbacks
 
 import (
+	"fmt"
 	"reflect"
 	"sort"
+	"strings"
 
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -55,6 +57,7 @@ func BeforeUpdate(db *gorm.DB) {
 // Update update hook
 func Update(config *Config) func(db *gorm.DB) {
 	supportReturning := utils.Contains(config.UpdateClauses, "RETURNING")
+	supportFrom := config.UpdateJoinStyle == FromJoinStyle
 
 	return func(db *gorm.DB) {
 		if db.Error != nil {
@@ -71,7 +74,14 @@ func Update(config *Config) func(db *gorm.DB) {
 			db.Statement.SQL.Grow(180)
 			db.Statement.AddClauseIfNotExists(clause.Update{})
 			if _, ok := db.Statement.Clauses["SET"]; !ok {
-				if set := ConvertToAssignments(db.Statement); len(set) != 0 {
+				var set clause.Set
+				if isBatchUpdating(db) {
+					set = convertToBatchAssignments(db.Statement, supportFrom)
+				} else {
+					set = ConvertToAssignments(db.Statement)
+				}
+
+				if len(set) != 0 {
 					defer delete(db.Statement.Clauses, "SET")
 					db.Statement.AddClause(set)
 				} else {
@@ -79,7 +89,19 @@ func Update(config *Config) func(db *gorm.DB) {
 				}
 			}
 
+			// check the assignments converted from the updating values and the preset assignments
+			if set, ok := db.Statement.Clauses["SET"].Expression.(clause.Set); ok {
+				for _, assignment := range set {
+					checkEnumValues(db.Statement, []clause.Column{assignment.Column}, []interface{}{assignment.Value})
+				}
+			}
+
+			if len(db.Statement.Joins) > 0 {
+				updateWithJoins(db, config.UpdateJoinStyle)
+			}
+
 			db.Statement.Build(db.Statement.BuildClauses...)
+			db.Statement.BuildComment()
 		}
 
 		checkMissingWhereConditions(db)
@@ -316,3 +338,241 @@ func ConvertToAssignments(stmt *gorm.Statement) (set clause.Set) {
 
 	return
 }
+
+// updateWithJoins restricts the updated rows with the joined tables in the join style of the dialect, e.g:
+//
+//	UPDATE users SET ... FROM companies WHERE ...
+//	UPDATE users JOIN companies ON ... SET users.name = ...
+//	UPDATE users SET ... WHERE users.id IN (SELECT * FROM (SELECT users.id FROM users JOIN ...) AS joined_users)
+func updateWithJoins(db *gorm.DB, style JoinStyle) {
+	joins := joinClauses(db)
+
+	switch style {
+	case FromJoinStyle:
+		fromClause, isFrom := clause.From{}, true
+		if c, ok := db.Statement.Clauses["FROM"]; ok {
+			// the FROM clause might be the rows of updating in batches
+			fromClause, isFrom = c.Expression.(clause.From)
+		}
+
+		if tables, conds, ok := joinTables(joins); ok && isFrom {
+			fromClause.Tables = append(fromClause.Tables, tables...)
+			db.Statement.AddClause(fromClause)
+
+			if len(conds) > 0 {
+				db.Statement.AddClause(clause.Where{Exprs: conds})
+			}
+			return
+		}
+	case MultiTableJoinStyle:
+		db.Statement.AddClause(clause.Update{Joins: joins})
+
+		// the assigned columns are qualified with the updating table
+		if c, ok := db.Statement.Clauses["SET"]; ok {
+			if set, ok := c.Expression.(clause.Set); ok {
+				assignments := make(clause.Set, len(set))
+				for idx, assignment := range set {
+					if assignment.Column.Table == "" && !assignment.Column.Raw {
+						assignment.Column.Table = clause.CurrentTable
+					}
+					assignments[idx] = assignment
+				}
+				db.Statement.AddClause(assignments)
+			}
+		}
+		return
+	}
+
+	cond, err := joinSubqueryCondition(db, joins)
+	if db.AddError(err) != nil {
+		return
+	}
+	db.Statement.AddClause(clause.Where{Exprs: []clause.Expression{cond}})
+}
+
+// isBatchUpdating updates the slice of the model with `db.UpdatesInBatches(&users, 100)`, each row is updated with its own values
+func isBatchUpdating(db *gorm.DB) bool {
+	if db.Statement.Schema == nil {
+		return false
+	}
+
+	if !db.Statement.UpdatingInBatches {
+		return false
+	}
+
+	switch db.Statement.ReflectValue.Kind() {
+	case reflect.Slice, reflect.Array:
+		elemType := db.Statement.ReflectValue.Type().Elem()
+		for elemType.Kind() == reflect.Ptr {
+			elemType = elemType.Elem()
+		}
+		return elemType.Kind() == reflect.Struct
+	}
+	return false
+}
+
+// convertToBatchAssignments converts the rows to assignments keyed by primary keys, updates all updatable fields
+// respecting Select/Omit, like `SET name = CASE WHEN id = 1 THEN 'jinzhu' WHEN id = 2 THEN 'josh' ELSE name END`,
+// or updates from the rows listed in VALUES if the dialect supports update from, like
+// `SET name = batch_rows.name FROM (VALUES (CAST(1 AS bigint),CAST('jinzhu' AS text)),(2,'josh')) AS batch_rows(id,name) WHERE id = batch_rows.id`
+func convertToBatchAssignments(stmt *gorm.Statement, supportFrom bool) (set clause.Set) {
+	var (
+		selectColumns, restricted = stmt.SelectAndOmitColumns(false, true)
+		primaryFields             = stmt.Schema.PrimaryFields
+		rows                      = make([]reflect.Value, stmt.ReflectValue.Len())
+		now                       = stmt.DB.NowFunc()
+		batchTable                = "batch_rows"
+	)
+
+	if len(primaryFields) == 0 {
+		stmt.AddError(fmt.Errorf("%w when updating in batches", gorm.ErrPrimaryKeyRequired))
+		return nil
+	}
+
+	for i := range rows {
+		if rows[i] = reflect.Indirect(stmt.ReflectValue.Index(i)); !rows[i].IsValid() {
+			stmt.AddError(fmt.Errorf("%w: nil element at index %d when updating in batches", gorm.ErrInvalidValue, i))
+			return nil
+		}
+
+		for _, field := range primaryFields {
+			if _, isZero := field.ValueOf(stmt.Context, rows[i]); isZero {
+				stmt.AddError(fmt.Errorf("%w when updating in batches", gorm.ErrPrimaryKeyRequired))
+				return nil
+			}
+		}
+	}
+
+	var fields []*schema.Field
+	for _, dbName := range stmt.Schema.DBNames {
+		field := stmt.Schema.FieldsByDBName[dbName]
+		if field.PrimaryKey {
+			continue
+		}
+
+		v, ok := selectColumns[dbName]
+		if !stmt.SkipHooks && field.AutoUpdateTime > 0 {
+			if (ok && v) || !ok {
+				var value interface{} = now
+				if field.AutoUpdateTime == schema.UnixNanosecond {
+					value = now.UnixNano()
+				} else if field.AutoUpdateTime == schema.UnixMillisecond {
+					value = now.UnixMilli()
+				} else if field.AutoUpdateTime == schema.UnixSecond {
+					value = now.Unix()
+				}
+
+				set = append(set, clause.Assignment{Column: clause.Column{Name: dbName}, Value: value})
+				for _, row := range rows {
+					if row.CanAddr() {
+						field.Set(stmt.Context, row, value)
+					}
+				}
+			}
+		} else if ((ok && v) || (!ok && !restricted)) && field.Updatable {
+			fields = append(fields, field)
+
+			// check the values of rows as they are assigned with CASE expressions or the batch table
+			if len(field.EnumValues) > 0 {
+				values := make([][]interface{}, len(rows))
+				for i, row := range rows {
+					value, _ := field.ValueOf(stmt.Context, row)
+					values[i] = []interface{}{value}
+				}
+				checkEnumValues(stmt, []clause.Column{{Name: dbName}}, values...)
+			}
+
+			if supportFrom {
+				set = append(set, clause.Assignment{Column: clause.Column{Name: dbName}, Value: clause.Column{Table: batchTable, Name: dbName}})
+			} else {
+				updating := clause.Case{Whens: make([]clause.When, len(rows)), Else: clause.Column{Table: clause.CurrentTable, Name: dbName}}
+				for i, row := range rows {
+					value, _ := field.ValueOf(stmt.Context, row)
+					updating.Whens[i] = clause.When{Condition: batchPrimaryCondition(stmt, row), Then: value}
+				}
+				set = append(set, clause.Assignment{Column: clause.Column{Name: dbName}, Value: updating})
+			}
+		}
+	}
+
+	if supportFrom && len(fields) > 0 {
+		updatingFields := append(append([]*schema.Field{}, primaryFields...), fields...)
+
+		var (
+			sql    strings.Builder
+			values = make([]interface{}, 0, len(rows)*len(updatingFields)+len(updatingFields)+1)
+		)
+
+		sql.WriteString("(VALUES ")
+		for idx, row := range rows {
+			if idx > 0 {
+				sql.WriteByte(',')
+			}
+
+			sql.WriteByte('(')
+			for i, field := range updatingFields {
+				if i > 0 {
+					sql.WriteByte(',')
+				}
+
+				// the column types of VALUES are taken from the first row
+				if dataType := batchDataTypeOf(stmt, field); idx == 0 && dataType != "" {
+					sql.WriteString("CAST(? AS " + dataType + ")")
+				} else {
+					sql.WriteByte('?')
+				}
+
+				v, _ := field.ValueOf(stmt.Context, row)
+				values = append(values, v)
+			}
+			sql.WriteByte(')')
+		}
+
+		sql.WriteString(") AS ?(")
+		values = append(values, clause.Table{Name: batchTable})
+		for idx, field := range updatingFields {
+			if idx > 0 {
+				sql.WriteByte(',')
+			}
+			sql.WriteByte('?')
+			values = append(values, clause.Column{Name: field.DBName})
+		}
+		sql.WriteByte(')')
+
+		stmt.Clauses["FROM"] = clause.Clause{Name: "FROM", Expression: clause.Expr{SQL: sql.String(), Vars: values}}
+
+		exprs := make([]clause.Expression, len(primaryFields))
+		for idx, field := range primaryFields {
+			exprs[idx] = clause.Eq{Column: clause.Column{Table: clause.CurrentTable, Name: field.DBName}, Value: clause.Column{Table: batchTable, Name: field.DBName}}
+		}
+		stmt.AddClause(clause.Where{Exprs: exprs})
+	} else {
+		_, primaryValues := schema.GetIdentityFieldValuesMap(stmt.Context, stmt.ReflectValue, primaryFields)
+		column, values := schema.ToQueryValues(clause.CurrentTable, stmt.Schema.PrimaryFieldDBNames, primaryValues)
+		stmt.AddClause(clause.Where{Exprs: []clause.Expression{clause.IN{Column: column, Values: values}}})
+	}
+
+	return set
+}
+
+// batchPrimaryCondition returns the condition matching the primary keys of the row
+func batchPrimaryCondition(stmt *gorm.Statement, row reflect.Value) clause.Expression {
+	exprs := make([]clause.Expression, len(stmt.Schema.PrimaryFields))
+	for idx, field := range stmt.Schema.PrimaryFields {
+		value, _ := field.ValueOf(stmt.Context, row)
+		exprs[idx] = clause.Eq{Column: clause.Column{Table: clause.CurrentTable, Name: field.DBName}, Value: value}
+	}
+
+	if len(exprs) == 1 {
+		return exprs[0]
+	}
+	return clause.And(exprs...)
+}
+
+// batchDataTypeOf returns the data type of the field to cast the values of updating in batches
+func batchDataTypeOf(stmt *gorm.Statement, field *schema.Field) string {
+	// auto increment types like `bigserial` are not castable
+	dataTypeField := *field
+	dataTypeField.AutoIncrement = false
+	return stmt.Dialector.DataTypeOf(&dataTypeField)
+}
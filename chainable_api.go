@@ -55,6 +55,17 @@ func (db *DB) Clauses(conds ...clause.Expression) (tx *DB) {
 	return
 }
 
+// Hints add optimizer or index hints to the statement, the dialector decides where and whether to render the hints,
+// they are dropped silently if not supported
+//
+//	db.Hints(clause.OptimizerHints("MAX_EXECUTION_TIME(1000)"), clause.UseIndex("idx_user_name")).Find(&users)
+//	// SELECT /*+ MAX_EXECUTION_TIME(1000) */ * FROM `users` USE INDEX (`idx_user_name`)
+func (db *DB) Hints(hints ...clause.Hint) (tx *DB) {
+	tx = db.getInstance()
+	tx.Statement.AddClause(clause.Hints{Hints: hints})
+	return
+}
+
 var tableRegexp = regexp.MustCompile(`(?i)(?:.+? AS (\w+)\s*(?:$|,)|^\w+\s+(\w+)$)`)
 
 // Table specify the table you would like to run db operations
@@ -85,6 +96,98 @@ func (db *DB) Table(name string, args ...interface{}) (tx *DB) {
 	return
 }
 
+// With specify a common table expression that can be referenced by name in the statement
+//
+// The subquery could be a *gorm.DB, a clause.Expression or a SQL string with args, name could contain
+// the column list of the CTE like `young_users(id, name)`.
+//
+//	// find users from the CTE young_users
+//	db.With("young_users", db.Model(&User{}).Where("age < ?", 18)).Table("young_users").Find(&users)
+//	// delete the users found in the CTE
+//	db.With("inactive", db.Model(&User{}).Select("id").Where("active = ?", false)).
+//		Where("id IN (?)", db.Table("inactive").Select("id")).Delete(&User{})
+func (db *DB) With(name string, subquery interface{}, args ...interface{}) (tx *DB) {
+	return with(db, false, name, subquery, args...)
+}
+
+// WithRecursive specify a recursive common table expression, see [DB.With]
+//
+//	db.WithRecursive("tree(id, parent_id)", "SELECT id, parent_id FROM categories WHERE id = ? UNION ALL "+
+//		"SELECT c.id, c.parent_id FROM categories c JOIN tree ON c.parent_id = tree.id", 1).Table("tree").Find(&nodes)
+func (db *DB) WithRecursive(name string, subquery interface{}, args ...interface{}) (tx *DB) {
+	return with(db, true, name, subquery, args...)
+}
+
+func with(db *DB, recursive bool, name string, subquery interface{}, args ...interface{}) (tx *DB) {
+	tx = db.getInstance()
+
+	cte := clause.CTE{Name: strings.TrimSpace(name)}
+	if idx := strings.IndexByte(cte.Name, '('); idx > 0 && strings.HasSuffix(cte.Name, ")") {
+		cte.Columns = strings.FieldsFunc(cte.Name[idx+1:len(cte.Name)-1], utils.IsInvalidDBNameChar)
+		cte.Name = strings.TrimSpace(cte.Name[:idx])
+	}
+
+	var ok bool
+	if cte.Subquery, ok = subqueryExpression(subquery, args...); !ok {
+		tx.AddError(fmt.Errorf("unsupported subquery %v for common table expression %s", subquery, name))
+		return
+	}
+
+	tx.Statement.AddClause(clause.With{Recursive: recursive, CTEs: []clause.CTE{cte}})
+	return
+}
+
+// Union combines the result with the query using UNION, duplicate rows are removed, ORDER BY and LIMIT
+// are applied to the combined result
+//
+// The query could be a *gorm.DB, a clause.Expression or a SQL string with args.
+//
+//	// find users named jinzhu or older than 18, ordered by name
+//	db.Model(&User{}).Where("name = ?", "jinzhu").Union(db.Model(&User{}).Where("age > ?", 18)).Order("name").Find(&users)
+func (db *DB) Union(query interface{}, args ...interface{}) (tx *DB) {
+	return setOperation(db, clause.Union, query, args...)
+}
+
+// UnionAll combines the result with the query using UNION ALL, duplicate rows are kept, see [DB.Union]
+func (db *DB) UnionAll(query interface{}, args ...interface{}) (tx *DB) {
+	return setOperation(db, clause.UnionAll, query, args...)
+}
+
+// Intersect only keeps rows that are also returned by the query, see [DB.Union]
+func (db *DB) Intersect(query interface{}, args ...interface{}) (tx *DB) {
+	return setOperation(db, clause.Intersect, query, args...)
+}
+
+// Except removes rows that are returned by the query, see [DB.Union]
+func (db *DB) Except(query interface{}, args ...interface{}) (tx *DB) {
+	return setOperation(db, clause.Except, query, args...)
+}
+
+func setOperation(db *DB, operator clause.SetOperator, query interface{}, args ...interface{}) (tx *DB) {
+	tx = db.getInstance()
+
+	expr, ok := subqueryExpression(query, args...)
+	if !ok {
+		tx.AddError(fmt.Errorf("unsupported query %v for %s", query, operator))
+		return
+	}
+
+	tx.Statement.AddClause(clause.SetOperations{{Operator: operator, Query: expr}})
+	return
+}
+
+func subqueryExpression(subquery interface{}, args ...interface{}) (clause.Expression, bool) {
+	switch v := subquery.(type) {
+	case string:
+		return clause.Expr{SQL: v, Vars: args}, true
+	case clause.Expression:
+		return v, true
+	case *DB:
+		return clause.Expr{SQL: "?", Vars: []interface{}{v}}, true
+	}
+	return nil, false
+}
+
 // Distinct specify distinct fields that you want querying
 //
 //	// Select distinct names of users
@@ -109,6 +212,10 @@ func (db *DB) Distinct(args ...interface{}) (tx *DB) {
 //	db.Select("name", "age").Find(&users)
 //	// Select name and age of user using an array
 //	db.Select([]string{"name", "age"}).Find(&users)
+//	// Select name and rank of user's age in the company using window function
+//	db.Select("name, ?", clause.Over{Function: clause.Expr{SQL: "RANK()"}, Window: clause.Window{
+//		PartitionBy: []clause.Column{{Name: "company_id"}}, OrderBy: []clause.OrderByColumn{{Column: clause.Column{Name: "age"}, Desc: true}},
+//	}, Alias: "age_rank"}).Find(&results)
 func (db *DB) Select(query interface{}, args ...interface{}) (tx *DB) {
 	tx = db.getInstance()
 
@@ -310,6 +417,8 @@ func (db *DB) Having(query interface{}, args ...interface{}) (tx *DB) {
 //		{Column: clause.Column{Name: "name"}, Desc: true},
 //		{Column: clause.Column{Name: "age"}, Desc: true},
 //	}})
+//	// order by window function
+//	db.Order(clause.Over{Function: clause.Expr{SQL: "RANK()"}, Window: clause.Window{PartitionBy: []clause.Column{{Name: "company_id"}}}})
 func (db *DB) Order(value interface{}) (tx *DB) {
 	tx = db.getInstance()
 
@@ -328,6 +437,10 @@ func (db *DB) Order(value interface{}) (tx *DB) {
 				}},
 			})
 		}
+	case clause.Expression:
+		tx.Statement.AddClause(clause.OrderBy{
+			Columns: []clause.OrderByColumn{{Expression: v}},
+		})
 	}
 	return
 }
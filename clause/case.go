@@ -0,0 +1,34 @@
+package clause
+
+// Case conditional expression, `CASE WHEN condition THEN result ... ELSE result END`
+//
+// Results and Else could be values, columns or expressions, values are bound as vars
+type Case struct {
+	Whens []When
+	Else  interface{}
+}
+
+// When branch of the case expression
+type When struct {
+	Condition Expression
+	Then      interface{}
+}
+
+// Build build case expression
+func (c Case) Build(builder Builder) {
+	builder.WriteString("CASE")
+	for _, when := range c.Whens {
+		builder.WriteString(" WHEN ")
+		if when.Condition != nil {
+			when.Condition.Build(builder)
+		}
+		builder.WriteString(" THEN ")
+		builder.AddVar(builder, when.Then)
+	}
+
+	if c.Else != nil {
+		builder.WriteString(" ELSE ")
+		builder.AddVar(builder, c.Else)
+	}
+	builder.WriteString(" END")
+}
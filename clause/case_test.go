@@ -0,0 +1,52 @@
+package clause_test
+
+import (
+	"fmt"
+	"testing"
+
+	"gorm.io/gorm/clause"
+)
+
+func TestCase(t *testing.T) {
+	level := clause.Case{
+		Whens: []clause.When{
+			{Condition: clause.Lt{Column: "age", Value: 18}, Then: "junior"},
+			{Condition: clause.And(clause.Gte{Column: "age", Value: 18}, clause.Lt{Column: "age", Value: 60}), Then: "adult"},
+		},
+		Else: "senior",
+	}
+
+	results := []struct {
+		Clauses []clause.Interface
+		Result  string
+		Vars    []interface{}
+	}{
+		{
+			[]clause.Interface{clause.Select{Expression: clause.Expr{SQL: "name, ? AS level", Vars: []interface{}{level}}}, clause.From{}},
+			"SELECT name, CASE WHEN `age` < ? THEN ? WHEN (`age` >= ? AND `age` < ?) THEN ? ELSE ? END AS level FROM `users`",
+			[]interface{}{18, "junior", 18, 60, "adult", "senior"},
+		},
+		{
+			[]clause.Interface{clause.Update{}, clause.Set([]clause.Assignment{{Column: clause.Column{Name: "role"}, Value: clause.Case{
+				Whens: []clause.When{{Condition: clause.IN{Column: clause.Column{Name: "id"}, Values: []interface{}{1, 2}}, Then: "admin"}},
+				Else:  clause.Column{Name: "role"},
+			}}})},
+			"UPDATE `users` SET `role`=CASE WHEN `id` IN (?,?) THEN ? ELSE `role` END",
+			[]interface{}{1, 2, "admin"},
+		},
+		{
+			[]clause.Interface{clause.Select{}, clause.From{}, clause.Where{Exprs: []clause.Expression{clause.Expr{SQL: "? = ?", Vars: []interface{}{level, "adult"}}}}, clause.OrderBy{Columns: []clause.OrderByColumn{
+				{Expression: clause.Case{Whens: []clause.When{{Condition: clause.Eq{Column: "name", Value: "jinzhu"}, Then: 0}}, Else: 1}},
+				{Column: clause.Column{Name: "name"}, Desc: true},
+			}}},
+			"SELECT * FROM `users` WHERE CASE WHEN `age` < ? THEN ? WHEN (`age` >= ? AND `age` < ?) THEN ? ELSE ? END = ? ORDER BY CASE WHEN `name` = ? THEN ? ELSE ? END,`name` DESC",
+			[]interface{}{18, "junior", 18, 60, "adult", "senior", "adult", "jinzhu", 0, 1},
+		},
+	}
+
+	for idx, result := range results {
+		t.Run(fmt.Sprintf("case #%v", idx), func(t *testing.T) {
+			checkBuildClauses(t, result.Clauses, result.Result, result.Vars)
+		})
+	}
+}
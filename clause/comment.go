@@ -0,0 +1,82 @@
+package clause
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// Comment comment clause, appended to the end of the statement, the tags are written in sqlcommenter format like
+// `/* traffic */ /*request_id='1',route='%2Fusers'*/`
+type Comment struct {
+	Text string
+	Tags map[string]string
+}
+
+// Name comment clause name
+func (comment Comment) Name() string {
+	return "COMMENT"
+}
+
+// Build build comment clause
+func (comment Comment) Build(builder Builder) {
+	if comment.Text != "" {
+		builder.WriteString("/* ")
+		builder.WriteString(strings.ReplaceAll(comment.Text, "*/", "* /"))
+		builder.WriteString(" */")
+	}
+
+	keys := make([]string, 0, len(comment.Tags))
+	for key, value := range comment.Tags {
+		if key != "" && value != "" {
+			keys = append(keys, key)
+		}
+	}
+
+	if len(keys) > 0 {
+		sort.Strings(keys)
+		if comment.Text != "" {
+			builder.WriteByte(' ')
+		}
+
+		builder.WriteString("/*")
+		for idx, key := range keys {
+			if idx > 0 {
+				builder.WriteByte(',')
+			}
+			builder.WriteString(commentEscape(key))
+			builder.WriteString("='")
+			builder.WriteString(commentEscape(comment.Tags[key]))
+			builder.WriteByte('\'')
+		}
+		builder.WriteString("*/")
+	}
+}
+
+// MergeClause merge comment clauses, the tags are merged with the previous tags
+func (comment Comment) MergeClause(clause *Clause) {
+	clause.Name = ""
+
+	if v, ok := clause.Expression.(Comment); ok {
+		if comment.Text == "" {
+			comment.Text = v.Text
+		}
+
+		if len(v.Tags) > 0 {
+			tags := make(map[string]string, len(v.Tags)+len(comment.Tags))
+			for key, value := range v.Tags {
+				tags[key] = value
+			}
+			for key, value := range comment.Tags {
+				tags[key] = value
+			}
+			comment.Tags = tags
+		}
+	}
+	clause.Expression = comment
+}
+
+// commentEscape url-encodes the key or value of sqlcommenter tags, quotes and comment delimiters are always encoded
+func commentEscape(s string) string {
+	return strings.ReplaceAll(url.QueryEscape(s), "+", "%20")
+}
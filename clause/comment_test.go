@@ -0,0 +1,39 @@
+package clause_test
+
+import (
+	"fmt"
+	"testing"
+
+	"gorm.io/gorm/clause"
+)
+
+func TestComment(t *testing.T) {
+	results := []struct {
+		Clauses []clause.Interface
+		Result  string
+		Vars    []interface{}
+	}{
+		{
+			[]clause.Interface{clause.Select{}, clause.From{}, clause.Comment{Text: "list users"}},
+			"SELECT * FROM `users` /* list users */", nil,
+		},
+		{
+			[]clause.Interface{clause.Select{}, clause.From{}, clause.Comment{Tags: map[string]string{"route": "/users", "request_id": "1"}}},
+			"SELECT * FROM `users` /*request_id='1',route='%2Fusers'*/", nil,
+		},
+		{
+			[]clause.Interface{clause.Select{}, clause.From{}, clause.Comment{Text: "evil */ DROP TABLE users; /*", Tags: map[string]string{"app name": "it's */--", "empty": ""}}},
+			"SELECT * FROM `users` /* evil * / DROP TABLE users; /* */ /*app%20name='it%27s%20%2A%2F--'*/", nil,
+		},
+		{
+			[]clause.Interface{clause.Select{}, clause.From{}, clause.Comment{Text: "users", Tags: map[string]string{"a": "1", "b": "2"}}, clause.Comment{Tags: map[string]string{"b": "3"}}},
+			"SELECT * FROM `users` /* users */ /*a='1',b='3'*/", nil,
+		},
+	}
+
+	for idx, result := range results {
+		t.Run(fmt.Sprintf("case #%v", idx), func(t *testing.T) {
+			checkBuildClauses(t, result.Clauses, result.Result, result.Vars)
+		})
+	}
+}
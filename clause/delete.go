@@ -1,7 +1,9 @@
 package clause
 
 type Delete struct {
-	Modifier string
+	Modifier     string
+	Tables       []Table    // tables to delete rows from, like `DELETE users FROM users JOIN ...`
+	KeywordHints Expression // hints after the keyword, like `/*+ MAX_EXECUTION_TIME(1000) */`
 }
 
 func (d Delete) Name() string {
@@ -11,10 +13,24 @@ func (d Delete) Name() string {
 func (d Delete) Build(builder Builder) {
 	builder.WriteString("DELETE")
 
+	if d.KeywordHints != nil {
+		builder.WriteByte(' ')
+		d.KeywordHints.Build(builder)
+	}
+
 	if d.Modifier != "" {
 		builder.WriteByte(' ')
 		builder.WriteString(d.Modifier)
 	}
+
+	for idx, table := range d.Tables {
+		if idx > 0 {
+			builder.WriteByte(',')
+		} else {
+			builder.WriteByte(' ')
+		}
+		builder.WriteQuoted(table)
+	}
 }
 
 func (d Delete) MergeClause(clause *Clause) {
@@ -21,6 +21,14 @@ func TestDelete(t *testing.T) {
 			[]clause.Interface{clause.Delete{Modifier: "LOW_PRIORITY"}, clause.From{}},
 			"DELETE LOW_PRIORITY FROM `users`", nil,
 		},
+		{
+			[]clause.Interface{clause.Delete{Tables: []clause.Table{{Name: clause.CurrentTable}}}, clause.From{Joins: []clause.Join{{Type: clause.InnerJoin, Table: clause.Table{Name: "blacklists"}, ON: clause.Where{Exprs: []clause.Expression{clause.Eq{Column: clause.Column{Table: "blacklists", Name: "email"}, Value: clause.Column{Table: clause.CurrentTable, Name: "email"}}}}}}}},
+			"DELETE `users` FROM `users` INNER JOIN `blacklists` ON `blacklists`.`email` = `users`.`email`", nil,
+		},
+		{
+			[]clause.Interface{clause.Delete{}, clause.From{}, clause.Using{Tables: []clause.Table{{Name: "blacklists"}}}, clause.Using{Tables: []clause.Table{{Name: "companies", Alias: "c"}}}, clause.Where{Exprs: []clause.Expression{clause.Expr{SQL: "blacklists.email = users.email AND c.id = ?", Vars: []interface{}{1}}}}},
+			"DELETE FROM `users` USING `blacklists`,`companies` `c` WHERE blacklists.email = users.email AND c.id = ?", []interface{}{1},
+		},
 	}
 
 	for idx, result := range results {
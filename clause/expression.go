@@ -3,10 +3,14 @@ package clause
 import (
 	"database/sql"
 	"database/sql/driver"
+	"errors"
 	"go/ast"
 	"reflect"
 )
 
+// ErrUnsupportedExpression the expression is not supported by the dialect
+var ErrUnsupportedExpression = errors.New("unsupported expression")
+
 // Expression expression interface
 type Expression interface {
 	Build(builder Builder)
@@ -364,6 +368,212 @@ func (like Like) NegationBuild(builder Builder) {
 	builder.AddVar(builder, like.Value)
 }
 
+// ILike whether string matches the pattern case-insensitively, built with LOWER() unless the dialect registers
+// the "ILIKE" and "NOT ILIKE" clause builders
+type ILike Eq
+
+func (ilike ILike) Build(builder Builder) {
+	if !buildWithClauseBuilder(builder, "ILIKE", ilike) {
+		ilike.build(builder, " LIKE ")
+	}
+}
+
+func (ilike ILike) NegationBuild(builder Builder) {
+	if !buildWithClauseBuilder(builder, "NOT ILIKE", ilike) {
+		ilike.build(builder, " NOT LIKE ")
+	}
+}
+
+func (ilike ILike) build(builder Builder, operator string) {
+	builder.WriteString("LOWER(")
+	builder.WriteQuoted(ilike.Column)
+	builder.WriteString(")")
+	builder.WriteString(operator)
+	builder.WriteString("LOWER(")
+	builder.AddVar(builder, ilike.Value)
+	builder.WriteByte(')')
+}
+
+// Regex whether string matches regular expression, built with `REGEXP` unless the dialect registers the "REGEXP" and
+// "NOT REGEXP" clause builders, e.g: `~` for postgres
+type Regex Eq
+
+func (regex Regex) Build(builder Builder) {
+	if !buildWithClauseBuilder(builder, "REGEXP", regex) {
+		regex.build(builder, " REGEXP ")
+	}
+}
+
+func (regex Regex) NegationBuild(builder Builder) {
+	if !buildWithClauseBuilder(builder, "NOT REGEXP", regex) {
+		regex.build(builder, " NOT REGEXP ")
+	}
+}
+
+func (regex Regex) build(builder Builder, operator string) {
+	builder.WriteQuoted(regex.Column)
+	builder.WriteString(operator)
+	builder.AddVar(builder, regex.Value)
+}
+
+// Between whether value is within the range, inclusive
+type Between struct {
+	Column interface{}
+	From   interface{}
+	To     interface{}
+}
+
+func (between Between) Build(builder Builder) {
+	builder.WriteQuoted(between.Column)
+	builder.WriteString(" BETWEEN ")
+	builder.AddVar(builder, between.From)
+	builder.WriteString(" AND ")
+	builder.AddVar(builder, between.To)
+}
+
+func (between Between) NegationBuild(builder Builder) {
+	NotBetween(between).Build(builder)
+}
+
+// NotBetween whether value is out of the range
+type NotBetween Between
+
+func (notBetween NotBetween) Build(builder Builder) {
+	builder.WriteQuoted(notBetween.Column)
+	builder.WriteString(" NOT BETWEEN ")
+	builder.AddVar(builder, notBetween.From)
+	builder.WriteString(" AND ")
+	builder.AddVar(builder, notBetween.To)
+}
+
+func (notBetween NotBetween) NegationBuild(builder Builder) {
+	Between(notBetween).Build(builder)
+}
+
+// IsNull whether value is null
+type IsNull struct {
+	Column interface{}
+}
+
+func (isNull IsNull) Build(builder Builder) {
+	builder.WriteQuoted(isNull.Column)
+	builder.WriteString(" IS NULL")
+}
+
+func (isNull IsNull) NegationBuild(builder Builder) {
+	IsNotNull(isNull).Build(builder)
+}
+
+// IsNotNull whether value is not null
+type IsNotNull IsNull
+
+func (isNotNull IsNotNull) Build(builder Builder) {
+	builder.WriteQuoted(isNotNull.Column)
+	builder.WriteString(" IS NOT NULL")
+}
+
+func (isNotNull IsNotNull) NegationBuild(builder Builder) {
+	IsNull(isNotNull).Build(builder)
+}
+
+// Exists whether the subquery returns any rows, Subquery could be a *gorm.DB or an expression
+type Exists struct {
+	Subquery interface{}
+}
+
+func (exists Exists) Build(builder Builder) {
+	builder.WriteString("EXISTS (")
+	builder.AddVar(builder, exists.Subquery)
+	builder.WriteByte(')')
+}
+
+func (exists Exists) NegationBuild(builder Builder) {
+	NotExists(exists).Build(builder)
+}
+
+// NotExists whether the subquery returns no rows
+type NotExists Exists
+
+func (notExists NotExists) Build(builder Builder) {
+	builder.WriteString("NOT EXISTS (")
+	builder.AddVar(builder, notExists.Subquery)
+	builder.WriteByte(')')
+}
+
+func (notExists NotExists) NegationBuild(builder Builder) {
+	Exists(notExists).Build(builder)
+}
+
+// Any compares value with the results of the subquery, true if any comparison is true, Operator defaults to `=`,
+// dialects without ANY could register the "ANY" clause builder to rewrite it
+type Any struct {
+	Column   interface{}
+	Operator string
+	Subquery interface{}
+}
+
+func (anyExpr Any) Build(builder Builder) {
+	if !buildWithClauseBuilder(builder, "ANY", anyExpr) {
+		anyExpr.build(builder, "ANY")
+	}
+}
+
+func (anyExpr Any) NegationBuild(builder Builder) {
+	builder.WriteString("NOT (")
+	anyExpr.Build(builder)
+	builder.WriteByte(')')
+}
+
+func (anyExpr Any) build(builder Builder, quantifier string) {
+	builder.WriteQuoted(anyExpr.Column)
+	builder.WriteByte(' ')
+	if anyExpr.Operator == "" {
+		builder.WriteByte('=')
+	} else {
+		builder.WriteString(anyExpr.Operator)
+	}
+	builder.WriteByte(' ')
+	builder.WriteString(quantifier)
+	builder.WriteString(" (")
+	builder.AddVar(builder, anyExpr.Subquery)
+	builder.WriteByte(')')
+}
+
+// All compares value with the results of the subquery, true if all comparisons are true, Operator defaults to `=`,
+// dialects without ALL could register the "ALL" clause builder to rewrite it
+type All Any
+
+func (allExpr All) Build(builder Builder) {
+	if !buildWithClauseBuilder(builder, "ALL", allExpr) {
+		Any(allExpr).build(builder, "ALL")
+	}
+}
+
+func (allExpr All) NegationBuild(builder Builder) {
+	builder.WriteString("NOT (")
+	allExpr.Build(builder)
+	builder.WriteByte(')')
+}
+
+// clauseBuilder returns the clause builder registered with name by the dialect
+func clauseBuilder(builder Builder, name string) (ClauseBuilder, bool) {
+	if b, ok := builder.(interface {
+		ClauseBuilder(name string) (ClauseBuilder, bool)
+	}); ok {
+		return b.ClauseBuilder(name)
+	}
+	return nil, false
+}
+
+// buildWithClauseBuilder builds the expression with the clause builder registered by the dialect, returns false if not found
+func buildWithClauseBuilder(builder Builder, name string, expr Expression) bool {
+	if b, ok := clauseBuilder(builder, name); ok {
+		b(Clause{Name: name, Expression: expr}, builder)
+		return true
+	}
+	return false
+}
+
 func eqNil(value interface{}) bool {
 	if valuer, ok := value.(driver.Valuer); ok && !eqNilReflect(valuer) {
 		value, _ = valuer.Value()
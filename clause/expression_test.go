@@ -2,6 +2,7 @@ package clause_test
 
 import (
 	"database/sql"
+	"errors"
 	"fmt"
 	"reflect"
 	"sync"
@@ -226,6 +227,80 @@ func TestExpression(t *testing.T) {
 		},
 		ExpectedVars: []interface{}{100},
 		Result:       "SUM(`users`.`id`) >= ?",
+	}, {
+		Expressions: []clause.Expression{
+			clause.Between{Column: column, From: 1, To: 10},
+			clause.Not(clause.NotBetween{Column: column, From: 1, To: 10}),
+		},
+		ExpectedVars: []interface{}{1, 10},
+		Result:       "`column-name` BETWEEN ? AND ?",
+	}, {
+		Expressions: []clause.Expression{
+			clause.NotBetween{Column: column, From: 1, To: 10},
+			clause.Not(clause.Between{Column: column, From: 1, To: 10}),
+		},
+		ExpectedVars: []interface{}{1, 10},
+		Result:       "`column-name` NOT BETWEEN ? AND ?",
+	}, {
+		Expressions: []clause.Expression{
+			clause.IsNull{Column: column},
+			clause.Not(clause.IsNotNull{Column: column}),
+		},
+		Result: "`column-name` IS NULL",
+	}, {
+		Expressions: []clause.Expression{
+			clause.IsNotNull{Column: column},
+			clause.Not(clause.IsNull{Column: column}),
+		},
+		Result: "`column-name` IS NOT NULL",
+	}, {
+		Expressions: []clause.Expression{
+			clause.ILike{Column: column, Value: "%Jinzhu%"},
+		},
+		ExpectedVars: []interface{}{"%Jinzhu%"},
+		Result:       "LOWER(`column-name`) LIKE LOWER(?)",
+	}, {
+		Expressions: []clause.Expression{
+			clause.Not(clause.ILike{Column: column, Value: "%Jinzhu%"}),
+		},
+		ExpectedVars: []interface{}{"%Jinzhu%"},
+		Result:       "LOWER(`column-name`) NOT LIKE LOWER(?)",
+	}, {
+		Expressions: []clause.Expression{
+			clause.Regex{Column: column, Value: "^jin"},
+		},
+		ExpectedVars: []interface{}{"^jin"},
+		Result:       "`column-name` REGEXP ?",
+	}, {
+		Expressions: []clause.Expression{
+			clause.Not(clause.Regex{Column: column, Value: "^jin"}),
+		},
+		ExpectedVars: []interface{}{"^jin"},
+		Result:       "`column-name` NOT REGEXP ?",
+	}, {
+		Expressions: []clause.Expression{
+			clause.Exists{Subquery: clause.Expr{SQL: "SELECT 1 FROM pets WHERE pets.user_id = users.id AND name = ?", Vars: []interface{}{"pet"}}},
+			clause.Not(clause.NotExists{Subquery: clause.Expr{SQL: "SELECT 1 FROM pets WHERE pets.user_id = users.id AND name = ?", Vars: []interface{}{"pet"}}}),
+		},
+		ExpectedVars: []interface{}{"pet"},
+		Result:       "EXISTS (SELECT 1 FROM pets WHERE pets.user_id = users.id AND name = ?)",
+	}, {
+		Expressions: []clause.Expression{
+			clause.NotExists{Subquery: clause.Expr{SQL: "SELECT 1 FROM pets"}},
+			clause.Not(clause.Exists{Subquery: clause.Expr{SQL: "SELECT 1 FROM pets"}}),
+		},
+		Result: "NOT EXISTS (SELECT 1 FROM pets)",
+	}, {
+		Expressions: []clause.Expression{
+			clause.Any{Column: column, Subquery: clause.Expr{SQL: "SELECT name FROM pets"}},
+		},
+		Result: "`column-name` = ANY (SELECT name FROM pets)",
+	}, {
+		Expressions: []clause.Expression{
+			clause.Not(clause.All{Column: column, Operator: ">", Subquery: clause.Expr{SQL: "SELECT age FROM pets WHERE age > ?", Vars: []interface{}{1}}}),
+		},
+		ExpectedVars: []interface{}{1},
+		Result:       "NOT (`column-name` > ALL (SELECT age FROM pets WHERE age > ?))",
 	}}
 
 	for idx, result := range results {
@@ -245,3 +320,177 @@ func TestExpression(t *testing.T) {
 		}
 	}
 }
+
+func TestExpressionWithClauseBuilder(t *testing.T) {
+	db, _ := gorm.Open(tests.DummyDialector{}, nil)
+	db.ClauseBuilders["ILIKE"] = func(c clause.Clause, builder clause.Builder) {
+		ilike := c.Expression.(clause.ILike)
+		builder.WriteQuoted(ilike.Column)
+		builder.WriteString(" ILIKE ")
+		builder.AddVar(builder, ilike.Value)
+	}
+	db.ClauseBuilders["REGEXP"] = func(c clause.Clause, builder clause.Builder) {
+		regex := c.Expression.(clause.Regex)
+		builder.WriteQuoted(regex.Column)
+		builder.WriteString(" REGEXP ")
+		builder.AddVar(builder, regex.Value)
+	}
+	db.ClauseBuilders["ANY"] = func(c clause.Clause, builder clause.Builder) {
+		anyExpr := c.Expression.(clause.Any)
+		builder.WriteQuoted(anyExpr.Column)
+		builder.WriteString(" IN (")
+		builder.AddVar(builder, anyExpr.Subquery)
+		builder.WriteByte(')')
+	}
+	db.ClauseBuilders["NOT REGEXP"] = func(c clause.Clause, builder clause.Builder) {
+		regex := c.Expression.(clause.Regex)
+		builder.WriteQuoted(regex.Column)
+		builder.WriteString(" !~ ")
+		builder.AddVar(builder, regex.Value)
+	}
+	db.ClauseBuilders["EXCLUDED"] = func(c clause.Clause, builder clause.Builder) {
+		builder.WriteString("VALUES(")
+		builder.WriteQuoted(string(c.Expression.(clause.Excluded)))
+		builder.WriteByte(')')
+	}
+
+	results := []struct {
+		Expression   clause.Expression
+		ExpectedVars []interface{}
+		Result       string
+	}{{
+		Expression:   clause.ILike{Column: "name", Value: "%jinzhu%"},
+		ExpectedVars: []interface{}{"%jinzhu%"},
+		Result:       "`name` ILIKE ?",
+	}, {
+		Expression:   clause.Not(clause.ILike{Column: "name", Value: "%jinzhu%"}),
+		ExpectedVars: []interface{}{"%jinzhu%"},
+		Result:       "LOWER(`name`) NOT LIKE LOWER(?)",
+	}, {
+		Expression:   clause.Regex{Column: "name", Value: "^jin"},
+		ExpectedVars: []interface{}{"^jin"},
+		Result:       "`name` REGEXP ?",
+	}, {
+		Expression: clause.Not(clause.Any{Column: "name", Subquery: clause.Expr{SQL: "SELECT name FROM pets"}}),
+		Result:     "NOT (`name` IN (SELECT name FROM pets))",
+	}, {
+		Expression: clause.All{Column: "name", Operator: "<>", Subquery: clause.Expr{SQL: "SELECT name FROM pets"}},
+		Result:     "`name` <> ALL (SELECT name FROM pets)",
+	}, {
+		Expression:   clause.Not(clause.Regex{Column: "name", Value: "^jin"}),
+		ExpectedVars: []interface{}{"^jin"},
+		Result:       "`name` !~ ?",
+	}, {
+		Expression:   clause.AddExcluded("age").Value.(clause.Expression),
+		ExpectedVars: nil,
+		Result:       "`users`.`age` + VALUES(`age`)",
+	}}
+
+	for idx, result := range results {
+		t.Run(fmt.Sprintf("case #%v", idx), func(t *testing.T) {
+			stmt := &gorm.Statement{DB: db, Table: "users", Clauses: map[string]clause.Clause{}}
+			result.Expression.Build(stmt)
+			if stmt.SQL.String() != result.Result {
+				t.Errorf("generated SQL is not equal, expects %v, but got %v", result.Result, stmt.SQL.String())
+			}
+
+			if !reflect.DeepEqual(result.ExpectedVars, stmt.Vars) {
+				t.Errorf("generated vars is not equal, expects %v, but got %v", result.ExpectedVars, stmt.Vars)
+			}
+		})
+	}
+}
+
+func TestMySQLClauseBuilders(t *testing.T) {
+	db, _ := gorm.Open(tests.DummyDialector{}, nil)
+	gorm.RegisterClauseBuilders(db, gorm.MySQLClauseBuilders)
+
+	stmt := &gorm.Statement{DB: db.Session(&gorm.Session{}), Table: "users", Clauses: map[string]clause.Clause{}}
+	clause.Set{clause.AddExcluded("age")}.Build(stmt)
+	if sql := stmt.SQL.String(); sql != "`age`=`users`.`age` + VALUES(`age`)" {
+		t.Errorf("expects excluded built with VALUES, got %v", sql)
+	}
+
+	stmt = &gorm.Statement{DB: db.Session(&gorm.Session{}), Table: "users", Clauses: map[string]clause.Clause{}}
+	stmt.AddClause(clause.Hints{Hints: []clause.Hint{clause.OptimizerHints("NO_ICP(users)"), clause.ForceIndex("idx_name")}})
+	stmt.AddClause(clause.Select{})
+	stmt.AddClause(clause.From{})
+	stmt.Build("SELECT", "FROM")
+	if sql := stmt.SQL.String(); sql != "SELECT /*+ NO_ICP(users) */ * FROM `users` FORCE INDEX (`idx_name`)" {
+		t.Errorf("expects hints built in their positions, got %v", sql)
+	}
+
+	stmt = &gorm.Statement{DB: db.Session(&gorm.Session{}), Table: "users", Clauses: map[string]clause.Clause{}}
+	stmt.AddClause(clause.GroupBy{Columns: []clause.Column{{Name: "year"}}, Rollup: []clause.Column{{Name: "month"}}})
+	stmt.Build("GROUP BY")
+	if sql := stmt.SQL.String(); sql != "GROUP BY `year`,`month` WITH ROLLUP" {
+		t.Errorf("expects rollup built with WITH ROLLUP, got %v", sql)
+	}
+
+	stmt = &gorm.Statement{DB: db.Session(&gorm.Session{}), Table: "users", Clauses: map[string]clause.Clause{}}
+	stmt.AddClause(clause.GroupBy{Cube: []clause.Column{{Name: "year"}}})
+	stmt.Build("GROUP BY")
+	if !errors.Is(stmt.Error, clause.ErrUnsupportedExpression) {
+		t.Errorf("expects unsupported error for cube, got %v", stmt.Error)
+	}
+}
+
+func TestRegisterClauseBuilders(t *testing.T) {
+	db, _ := gorm.Open(tests.DummyDialector{}, nil)
+	gorm.RegisterClauseBuilders(db, gorm.SQLiteClauseBuilders)
+	gorm.RegisterClauseBuilders(db, gorm.PostgresClauseBuilders)
+	gorm.RegisterClauseBuilders(db, gorm.SQLServerClauseBuilders)
+
+	results := []struct {
+		Expression   clause.Expression
+		ExpectedVars []interface{}
+		Result       string
+		Error        error
+	}{{
+		Expression:   clause.Regex{Column: "name", Value: "^jin"},
+		ExpectedVars: []interface{}{"^jin"},
+		Result:       "`name` ~ ?",
+	}, {
+		Expression:   clause.Not(clause.ILike{Column: "name", Value: "%jinzhu%"}),
+		ExpectedVars: []interface{}{"%jinzhu%"},
+		Result:       "`name` NOT ILIKE ?",
+	}, {
+		Expression: clause.Any{Column: "name", Subquery: clause.Expr{SQL: "SELECT name FROM pets"}},
+		Result:     "`name` IN (SELECT name FROM pets)",
+	}, {
+		Expression: clause.All{Column: "name", Operator: "<>", Subquery: clause.Expr{SQL: "SELECT name FROM pets"}},
+		Result:     "`name` NOT IN (SELECT name FROM pets)",
+	}, {
+		Expression: clause.Any{Column: "age", Operator: ">", Subquery: clause.Expr{SQL: "SELECT age FROM pets"}},
+		Error:      clause.ErrUnsupportedExpression,
+	}}
+
+	for idx, result := range results {
+		t.Run(fmt.Sprintf("case #%v", idx), func(t *testing.T) {
+			stmt := &gorm.Statement{DB: db.Session(&gorm.Session{}), Table: "users", Clauses: map[string]clause.Clause{}}
+			result.Expression.Build(stmt)
+			if result.Error != nil {
+				if !errors.Is(stmt.Error, result.Error) {
+					t.Errorf("expects error %v, got %v", result.Error, stmt.Error)
+				}
+				return
+			}
+
+			if stmt.SQL.String() != result.Result {
+				t.Errorf("generated SQL is not equal, expects %v, but got %v", result.Result, stmt.SQL.String())
+			}
+
+			if !reflect.DeepEqual(result.ExpectedVars, stmt.Vars) {
+				t.Errorf("generated vars is not equal, expects %v, but got %v", result.ExpectedVars, stmt.Vars)
+			}
+		})
+	}
+
+	db, _ = gorm.Open(tests.DummyDialector{}, nil)
+	gorm.RegisterClauseBuilders(db, gorm.SQLServerClauseBuilders)
+	stmt := &gorm.Statement{DB: db.Session(&gorm.Session{}), Table: "users", Clauses: map[string]clause.Clause{}}
+	clause.Not(clause.Regex{Column: "name", Value: "^jin"}).Build(stmt)
+	if sql := stmt.SQL.String(); sql != "NOT REGEXP_LIKE(`name`,?)" {
+		t.Errorf("generated SQL is not equal, expects %v, but got %v", "NOT REGEXP_LIKE(`name`,?)", sql)
+	}
+}
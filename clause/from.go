@@ -2,8 +2,9 @@ package clause
 
 // From from clause
 type From struct {
-	Tables []Table
-	Joins  []Join
+	Tables     []Table
+	Joins      []Join
+	TableHints Expression // hints after the first table, like `USE INDEX (idx_name)`
 }
 
 // Name from clause name
@@ -20,9 +21,17 @@ func (from From) Build(builder Builder) {
 			}
 
 			builder.WriteQuoted(table)
+			if idx == 0 && from.TableHints != nil {
+				builder.WriteByte(' ')
+				from.TableHints.Build(builder)
+			}
 		}
 	} else {
 		builder.WriteQuoted(currentTable)
+		if from.TableHints != nil {
+			builder.WriteByte(' ')
+			from.TableHints.Build(builder)
+		}
 	}
 
 	for _, join := range from.Joins {
@@ -17,6 +17,10 @@ func TestFrom(t *testing.T) {
 			[]clause.Interface{clause.Select{}, clause.From{}},
 			"SELECT * FROM `users`", nil,
 		},
+		{
+			[]clause.Interface{clause.Select{}, clause.From{Tables: []clause.Table{{Name: "users"}, {Name: "companies"}}, TableHints: clause.ForceIndex("idx_name")}},
+			"SELECT * FROM `users` FORCE INDEX (`idx_name`),`companies`", nil,
+		},
 		{
 			[]clause.Interface{
 				clause.Select{}, clause.From{
@@ -2,8 +2,11 @@ package clause
 
 // GroupBy group by clause
 type GroupBy struct {
-	Columns []Column
-	Having  []Expression
+	Columns      []Column
+	Having       []Expression
+	Rollup       []Column   // subtotals from right to left, like `ROLLUP (year,month)`
+	Cube         []Column   // subtotals of all combinations, like `CUBE (region,product)`
+	GroupingSets [][]Column // explicit groups, blank group is the grand total, like `GROUPING SETS ((region),())`
 }
 
 // Name from clause name
@@ -11,22 +14,84 @@ func (groupBy GroupBy) Name() string {
 	return "GROUP BY"
 }
 
-// Build build group by clause
+// Build build group by clause, ROLLUP is built by the "ROLLUP" clause builder if registered and it is the only
+// grouping modifier, e.g: WithRollup for MySQL
 func (groupBy GroupBy) Build(builder Builder) {
-	for idx, column := range groupBy.Columns {
+	if len(groupBy.Rollup) > 0 && len(groupBy.Cube) == 0 && len(groupBy.GroupingSets) == 0 &&
+		buildWithClauseBuilder(builder, "ROLLUP", groupBy) {
+		return
+	}
+
+	written := len(groupBy.Columns) > 0
+	groupBy.buildColumns(builder, groupBy.Columns)
+
+	for _, modifier := range []struct {
+		name    string
+		columns []Column
+	}{{"ROLLUP", groupBy.Rollup}, {"CUBE", groupBy.Cube}} {
+		if len(modifier.columns) > 0 {
+			if written {
+				builder.WriteByte(',')
+			}
+			written = true
+
+			builder.WriteString(modifier.name)
+			builder.WriteString(" (")
+			groupBy.buildColumns(builder, modifier.columns)
+			builder.WriteByte(')')
+		}
+	}
+
+	if len(groupBy.GroupingSets) > 0 {
+		if written {
+			builder.WriteByte(',')
+		}
+
+		builder.WriteString("GROUPING SETS (")
+		for idx, columns := range groupBy.GroupingSets {
+			if idx > 0 {
+				builder.WriteByte(',')
+			}
+
+			builder.WriteByte('(')
+			groupBy.buildColumns(builder, columns)
+			builder.WriteByte(')')
+		}
+		builder.WriteByte(')')
+	}
+
+	groupBy.buildHaving(builder)
+}
+
+func (groupBy GroupBy) buildColumns(builder Builder, columns []Column) {
+	for idx, column := range columns {
 		if idx > 0 {
 			builder.WriteByte(',')
 		}
 
 		builder.WriteQuoted(column)
 	}
+}
 
+func (groupBy GroupBy) buildHaving(builder Builder) {
 	if len(groupBy.Having) > 0 {
 		builder.WriteString(" HAVING ")
 		Where{Exprs: groupBy.Having}.Build(builder)
 	}
 }
 
+// WithRollup builds ROLLUP in the form of `GROUP BY year,month WITH ROLLUP`, registered as the "ROLLUP" clause
+// builder for the dialects only support this form, like MySQL
+func WithRollup(c Clause, builder Builder) {
+	if groupBy, ok := c.Expression.(GroupBy); ok {
+		columns := make([]Column, 0, len(groupBy.Columns)+len(groupBy.Rollup))
+		columns = append(append(columns, groupBy.Columns...), groupBy.Rollup...)
+		groupBy.buildColumns(builder, columns)
+		builder.WriteString(" WITH ROLLUP")
+		groupBy.buildHaving(builder)
+	}
+}
+
 // MergeClause merge group by clause
 func (groupBy GroupBy) MergeClause(clause *Clause) {
 	if v, ok := clause.Expression.(GroupBy); ok {
@@ -37,12 +102,35 @@ func (groupBy GroupBy) MergeClause(clause *Clause) {
 		copiedHaving := make([]Expression, len(v.Having))
 		copy(copiedHaving, v.Having)
 		groupBy.Having = append(copiedHaving, groupBy.Having...)
+
+		copiedRollup := make([]Column, len(v.Rollup))
+		copy(copiedRollup, v.Rollup)
+		groupBy.Rollup = append(copiedRollup, groupBy.Rollup...)
+
+		copiedCube := make([]Column, len(v.Cube))
+		copy(copiedCube, v.Cube)
+		groupBy.Cube = append(copiedCube, groupBy.Cube...)
+
+		copiedGroupingSets := make([][]Column, len(v.GroupingSets))
+		copy(copiedGroupingSets, v.GroupingSets)
+		groupBy.GroupingSets = append(copiedGroupingSets, groupBy.GroupingSets...)
 	}
 	clause.Expression = groupBy
 
-	if len(groupBy.Columns) == 0 {
+	if len(groupBy.Columns) == 0 && len(groupBy.Rollup) == 0 && len(groupBy.Cube) == 0 && len(groupBy.GroupingSets) == 0 {
 		clause.Name = ""
 	} else {
 		clause.Name = groupBy.Name()
 	}
 }
+
+// Grouping tells whether the column is aggregated in the subtotal rows of ROLLUP, CUBE or GROUPING SETS, returns 1
+// for the subtotal rows, like `GROUPING(region)`
+type Grouping Column
+
+// Build build grouping expression
+func (grouping Grouping) Build(builder Builder) {
+	builder.WriteString("GROUPING(")
+	builder.WriteQuoted(Column(grouping))
+	builder.WriteByte(')')
+}
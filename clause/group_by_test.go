@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"testing"
 
+	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
+	"gorm.io/gorm/utils/tests"
 )
 
 func TestGroupBy(t *testing.T) {
@@ -32,6 +34,37 @@ func TestGroupBy(t *testing.T) {
 			"SELECT * FROM `users` GROUP BY `role`,`gender` HAVING `role` = ? AND `gender` <> ?",
 			[]interface{}{"admin", "U"},
 		},
+		{
+			[]clause.Interface{clause.Select{Columns: []clause.Column{{Name: "role"}, {Name: "gender"}}}, clause.From{}, clause.GroupBy{
+				Rollup: []clause.Column{{Name: "role"}, {Name: "gender"}},
+			}},
+			"SELECT `role`,`gender` FROM `users` GROUP BY ROLLUP (`role`,`gender`)", nil,
+		},
+		{
+			[]clause.Interface{clause.Select{}, clause.From{}, clause.GroupBy{
+				Columns: []clause.Column{{Name: "company_id"}},
+				Having:  []clause.Expression{clause.Gt{Column: "age", Value: 18}},
+			}, clause.GroupBy{
+				Cube: []clause.Column{{Name: "role"}, {Name: "gender"}},
+			}},
+			"SELECT * FROM `users` GROUP BY `company_id`,CUBE (`role`,`gender`) HAVING `age` > ?",
+			[]interface{}{18},
+		},
+		{
+			[]clause.Interface{clause.Select{}, clause.From{}, clause.GroupBy{
+				GroupingSets: [][]clause.Column{{{Name: "role"}, {Name: "gender"}}, {{Name: "role"}}, {}},
+			}},
+			"SELECT * FROM `users` GROUP BY GROUPING SETS ((`role`,`gender`),(`role`),())", nil,
+		},
+		{
+			[]clause.Interface{clause.Select{Expression: clause.Expr{
+				SQL:  "?,? AS role_total",
+				Vars: []interface{}{clause.Column{Name: "role"}, clause.Grouping{Name: "role"}},
+			}}, clause.From{}, clause.GroupBy{
+				Rollup: []clause.Column{{Name: "role"}},
+			}},
+			"SELECT `role`,GROUPING(`role`) AS role_total FROM `users` GROUP BY ROLLUP (`role`)", nil,
+		},
 	}
 
 	for idx, result := range results {
@@ -40,3 +73,33 @@ func TestGroupBy(t *testing.T) {
 		})
 	}
 }
+
+func TestGroupByWithRollup(t *testing.T) {
+	db, _ := gorm.Open(tests.DummyDialector{}, nil)
+	db.ClauseBuilders["ROLLUP"] = clause.WithRollup
+
+	results := []struct {
+		GroupBy clause.GroupBy
+		Result  string
+	}{{
+		GroupBy: clause.GroupBy{Columns: []clause.Column{{Name: "year"}}, Rollup: []clause.Column{{Name: "month"}}},
+		Result:  "GROUP BY `year`,`month` WITH ROLLUP",
+	}, {
+		GroupBy: clause.GroupBy{Rollup: []clause.Column{{Name: "year"}, {Name: "month"}}, Having: []clause.Expression{clause.Gt{Column: "total", Value: 10}}},
+		Result:  "GROUP BY `year`,`month` WITH ROLLUP HAVING `total` > ?",
+	}, {
+		GroupBy: clause.GroupBy{Cube: []clause.Column{{Name: "year"}}, Rollup: []clause.Column{{Name: "month"}}},
+		Result:  "GROUP BY ROLLUP (`month`),CUBE (`year`)",
+	}}
+
+	for idx, result := range results {
+		t.Run(fmt.Sprintf("case #%v", idx), func(t *testing.T) {
+			stmt := &gorm.Statement{DB: db, Table: "users", Clauses: map[string]clause.Clause{}}
+			stmt.AddClause(result.GroupBy)
+			stmt.Build("GROUP BY")
+			if stmt.SQL.String() != result.Result {
+				t.Errorf("SQL expects %v got %v", result.Result, stmt.SQL.String())
+			}
+		})
+	}
+}
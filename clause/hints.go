@@ -0,0 +1,135 @@
+package clause
+
+import "strings"
+
+// HintPosition the position of the hint in the statement
+type HintPosition int
+
+const (
+	// HintKeyword after the statement keyword, like `SELECT /*+ MAX_EXECUTION_TIME(1000) */ * FROM users`
+	HintKeyword HintPosition = iota
+	// HintTable after the table reference, like `SELECT * FROM users USE INDEX (idx_name)`
+	HintTable
+	// HintStatement before the statement, like `/*+ NO_INDEX(users) */ SELECT * FROM users`
+	HintStatement
+)
+
+// HintType the type of the hint
+type HintType string
+
+const (
+	HintRaw         HintType = ""
+	HintOptimizer   HintType = "OPTIMIZER"
+	HintUseIndex    HintType = "USE INDEX"
+	HintForceIndex  HintType = "FORCE INDEX"
+	HintIgnoreIndex HintType = "IGNORE INDEX"
+)
+
+// Hint optimizer or index hint of the statement, the hints are rendered by the dialector with the clause builder of
+// `HINT`, which could build the hint with `Build`, rewrite it or skip it, hints are dropped if the dialector doesn't
+// register the builder
+type Hint struct {
+	Position HintPosition
+	Type     HintType
+	Values   []string      // optimizer hints, index names or raw SQL
+	Vars     []interface{} // vars of the raw SQL
+	For      string        // scope of the index hint, like `JOIN`, `ORDER BY` or `GROUP BY`
+}
+
+// OptimizerHints optimizer hints after the statement keyword, like `SELECT /*+ MAX_EXECUTION_TIME(1000) */ ...`
+func OptimizerHints(hints ...string) Hint {
+	return Hint{Position: HintKeyword, Type: HintOptimizer, Values: hints}
+}
+
+// UseIndex index hint after the table reference, like `FROM users USE INDEX (idx_name)`
+func UseIndex(names ...string) Hint {
+	return Hint{Position: HintTable, Type: HintUseIndex, Values: names}
+}
+
+// ForceIndex index hint after the table reference, like `FROM users FORCE INDEX (idx_name)`
+func ForceIndex(names ...string) Hint {
+	return Hint{Position: HintTable, Type: HintForceIndex, Values: names}
+}
+
+// IgnoreIndex index hint after the table reference, like `FROM users IGNORE INDEX (idx_name)`
+func IgnoreIndex(names ...string) Hint {
+	return Hint{Position: HintTable, Type: HintIgnoreIndex, Values: names}
+}
+
+// ForJoin limits the index hint to finding rows for joins
+func (hint Hint) ForJoin() Hint {
+	hint.For = "JOIN"
+	return hint
+}
+
+// ForOrderBy limits the index hint to sorting rows
+func (hint Hint) ForOrderBy() Hint {
+	hint.For = "ORDER BY"
+	return hint
+}
+
+// ForGroupBy limits the index hint to grouping rows
+func (hint Hint) ForGroupBy() Hint {
+	hint.For = "GROUP BY"
+	return hint
+}
+
+// Build build hint
+func (hint Hint) Build(builder Builder) {
+	switch hint.Type {
+	case HintRaw:
+		Expr{SQL: strings.Join(hint.Values, " "), Vars: hint.Vars}.Build(builder)
+	case HintOptimizer:
+		builder.WriteString("/*+ ")
+		builder.WriteString(strings.ReplaceAll(strings.Join(hint.Values, " "), "*/", "* /"))
+		builder.WriteString(" */")
+	default:
+		builder.WriteString(string(hint.Type))
+		if hint.For != "" {
+			builder.WriteString(" FOR ")
+			builder.WriteString(hint.For)
+		}
+
+		builder.WriteString(" (")
+		for idx, name := range hint.Values {
+			if idx > 0 {
+				builder.WriteByte(',')
+			}
+			builder.WriteQuoted(name)
+		}
+		builder.WriteByte(')')
+	}
+}
+
+// Hints hints clause
+type Hints struct {
+	Hints []Hint
+}
+
+// Name hints clause name
+func (hints Hints) Name() string {
+	return "HINT"
+}
+
+// Build build hints clause, the hints are built by the statement in their positions
+func (hints Hints) Build(builder Builder) {
+	for idx, hint := range hints.Hints {
+		if idx > 0 {
+			builder.WriteByte(' ')
+		}
+		hint.Build(builder)
+	}
+}
+
+// MergeClause merge hints clauses
+func (hints Hints) MergeClause(clause *Clause) {
+	clause.Name = ""
+
+	if v, ok := clause.Expression.(Hints); ok {
+		copiedHints := make([]Hint, len(v.Hints)+len(hints.Hints))
+		copy(copiedHints, v.Hints)
+		copy(copiedHints[len(v.Hints):], hints.Hints)
+		hints.Hints = copiedHints
+	}
+	clause.Expression = hints
+}
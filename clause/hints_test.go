@@ -0,0 +1,43 @@
+package clause_test
+
+import (
+	"fmt"
+	"testing"
+
+	"gorm.io/gorm/clause"
+)
+
+func TestHints(t *testing.T) {
+	results := []struct {
+		Clauses []clause.Interface
+		Result  string
+		Vars    []interface{}
+	}{
+		{
+			[]clause.Interface{clause.Hints{Hints: []clause.Hint{clause.OptimizerHints("MAX_EXECUTION_TIME(1000)", "NO_ICP(users)")}}},
+			"/*+ MAX_EXECUTION_TIME(1000) NO_ICP(users) */", nil,
+		},
+		{
+			[]clause.Interface{clause.Hints{Hints: []clause.Hint{clause.UseIndex("idx_name", "idx_age")}}, clause.Hints{Hints: []clause.Hint{clause.ForceIndex("idx_name").ForJoin()}}},
+			"USE INDEX (`idx_name`,`idx_age`) FORCE INDEX FOR JOIN (`idx_name`)", nil,
+		},
+		{
+			[]clause.Interface{clause.Hints{Hints: []clause.Hint{clause.IgnoreIndex("idx_name").ForOrderBy(), clause.UseIndex("idx_age").ForGroupBy()}}},
+			"IGNORE INDEX FOR ORDER BY (`idx_name`) USE INDEX FOR GROUP BY (`idx_age`)", nil,
+		},
+		{
+			[]clause.Interface{clause.Hints{Hints: []clause.Hint{{Position: clause.HintStatement, Values: []string{"/* raw */"}}, clause.OptimizerHints("evil */ hint")}}},
+			"/* raw */ /*+ evil * / hint */", nil,
+		},
+		{
+			[]clause.Interface{clause.Hints{Hints: []clause.Hint{{Position: clause.HintStatement, Values: []string{"SET STATEMENT max_statement_time=? FOR"}, Vars: []interface{}{1}}}}},
+			"SET STATEMENT max_statement_time=? FOR", []interface{}{1},
+		},
+	}
+
+	for idx, result := range results {
+		t.Run(fmt.Sprintf("case #%v", idx), func(t *testing.T) {
+			checkBuildClauses(t, result.Clauses, result.Result, result.Vars)
+		})
+	}
+}
@@ -16,6 +16,7 @@ type JoinTarget struct {
 	Association string
 	Subquery    Expression
 	Table       string
+	Lateral     bool
 }
 
 func Has(name string) JoinTarget {
@@ -30,6 +31,16 @@ func (jt JoinType) AssociationFrom(name string, subquery Expression) JoinTarget
 	return JoinTarget{Type: jt, Association: name, Subquery: subquery}
 }
 
+// Subquery joins the derived table of subquery, the alias specified by As is required
+func (jt JoinType) Subquery(subquery Expression) JoinTarget {
+	return JoinTarget{Type: jt, Subquery: subquery}
+}
+
+// Lateral joins the LATERAL subquery which could reference columns of preceding tables, the alias specified by As is required
+func (jt JoinType) Lateral(subquery Expression) JoinTarget {
+	return JoinTarget{Type: jt, Subquery: subquery, Lateral: true}
+}
+
 func (jt JoinTarget) As(name string) JoinTarget {
 	jt.Table = name
 	return jt
@@ -42,6 +53,8 @@ type Join struct {
 	ON         Where
 	Using      []string
 	Expression Expression
+	Subquery   Expression // join the derived table of subquery, Table is used as its alias
+	Lateral    bool
 }
 
 func JoinTable(names ...string) Table {
@@ -60,7 +73,21 @@ func (join Join) Build(builder Builder) {
 		}
 
 		builder.WriteString("JOIN ")
-		builder.WriteQuoted(join.Table)
+		if join.Subquery != nil {
+			if join.Lateral {
+				builder.WriteString("LATERAL ")
+			}
+			builder.WriteByte('(')
+			join.Subquery.Build(builder)
+			builder.WriteString(") AS ")
+			if join.Table.Alias != "" {
+				builder.WriteQuoted(join.Table.Alias)
+			} else {
+				builder.WriteQuoted(join.Table.Name)
+			}
+		} else {
+			builder.WriteQuoted(join.Table)
+		}
 
 		if len(join.ON.Exprs) > 0 {
 			builder.WriteString(" ON ")
@@ -74,6 +101,8 @@ func (join Join) Build(builder Builder) {
 				builder.WriteQuoted(c)
 			}
 			builder.WriteByte(')')
+		} else if join.Lateral && join.Type != CrossJoin {
+			builder.WriteString(" ON TRUE")
 		}
 	}
 }
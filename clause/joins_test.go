@@ -87,6 +87,38 @@ func TestJoin(t *testing.T) {
 			},
 			sql: "INNER JOIN `user` USING (`id`)",
 		},
+		{
+			name: "Subquery",
+			join: clause.Join{
+				Type:     clause.LeftJoin,
+				Table:    clause.Table{Name: "latest_order"},
+				Subquery: clause.Expr{SQL: "SELECT user_id, MAX(id) AS id FROM orders GROUP BY user_id"},
+				ON: clause.Where{
+					Exprs: []clause.Expression{clause.Eq{clause.Column{Table: "latest_order", Name: "user_id"}, clause.PrimaryColumn}},
+				},
+			},
+			sql: "LEFT JOIN (SELECT user_id, MAX(id) AS id FROM orders GROUP BY user_id) AS `latest_order` ON `latest_order`.`user_id` = `users`.`id`",
+		},
+		{
+			name: "LATERAL",
+			join: clause.Join{
+				Type:     clause.LeftJoin,
+				Table:    clause.Table{Name: "orders", Alias: "latest_orders"},
+				Subquery: clause.Expr{SQL: "SELECT * FROM orders WHERE orders.user_id = users.id ORDER BY id DESC LIMIT ?", Vars: []interface{}{3}},
+				Lateral:  true,
+			},
+			sql: "LEFT JOIN LATERAL (SELECT * FROM orders WHERE orders.user_id = users.id ORDER BY id DESC LIMIT ?) AS `latest_orders` ON TRUE",
+		},
+		{
+			name: "CROSS JOIN LATERAL",
+			join: clause.Join{
+				Type:     clause.CrossJoin,
+				Table:    clause.Table{Name: "latest_orders"},
+				Subquery: clause.Expr{SQL: "SELECT * FROM orders WHERE orders.user_id = users.id LIMIT 3"},
+				Lateral:  true,
+			},
+			sql: "CROSS JOIN LATERAL (SELECT * FROM orders WHERE orders.user_id = users.id LIMIT 3) AS `latest_orders`",
+		},
 	}
 	for _, result := range results {
 		t.Run(result.name, func(t *testing.T) {
@@ -0,0 +1,132 @@
+package clause
+
+// Merge merge statement, like `MERGE INTO users USING ... ON ... WHEN MATCHED THEN UPDATE SET ... WHEN NOT MATCHED THEN INSERT ...`
+type Merge struct {
+	Table Table // target table, uses current table if blank
+	Using MergeSource
+	On    []Expression
+	Whens []MergeWhen
+}
+
+// MergeSource source of merge statement, one of the table, subquery or values, the alias is used to reference the
+// source in the ON conditions and branches
+type MergeSource struct {
+	Table    Table
+	Subquery interface{}
+	Values   *Values // rows of the source, the columns are used as the column names of the source
+	Alias    string
+}
+
+// MergeWhen branch of merge statement, runs one of delete, update or insert for the rows (not) matched with the
+// condition, do nothing if no action specified (not supported by sqlserver), the rows are deleted permanently as
+// the statement is not modified by soft delete, update the deleted at column to soft delete them instead
+type MergeWhen struct {
+	Matched   bool
+	Condition Expression
+	Delete    bool
+	Update    Set
+	Insert    *Values
+}
+
+// Name merge clause name
+func (merge Merge) Name() string {
+	return "MERGE"
+}
+
+// Build build merge clause
+func (merge Merge) Build(builder Builder) {
+	builder.WriteString("MERGE INTO ")
+	if merge.Table.Name == "" {
+		builder.WriteQuoted(currentTable)
+	} else {
+		builder.WriteQuoted(merge.Table)
+	}
+
+	builder.WriteString(" USING ")
+	merge.Using.Build(builder)
+
+	if len(merge.On) > 0 {
+		builder.WriteString(" ON ")
+		Where{Exprs: merge.On}.Build(builder)
+	}
+
+	for _, when := range merge.Whens {
+		builder.WriteByte(' ')
+		when.Build(builder)
+	}
+}
+
+// MergeClause merge merge clause
+func (merge Merge) MergeClause(clause *Clause) {
+	clause.Name = ""
+	clause.Expression = merge
+}
+
+// Build build merge source
+func (source MergeSource) Build(builder Builder) {
+	switch {
+	case source.Values != nil:
+		builder.WriteString("(VALUES ")
+		for idx, value := range source.Values.Values {
+			if idx > 0 {
+				builder.WriteByte(',')
+			}
+
+			builder.WriteByte('(')
+			builder.AddVar(builder, value...)
+			builder.WriteByte(')')
+		}
+		builder.WriteByte(')')
+	case source.Subquery != nil:
+		builder.WriteByte('(')
+		builder.AddVar(builder, source.Subquery)
+		builder.WriteByte(')')
+	default:
+		builder.WriteQuoted(source.Table)
+		return
+	}
+
+	if source.Alias != "" {
+		builder.WriteString(" AS ")
+		builder.WriteQuoted(source.Alias)
+	}
+
+	if source.Values != nil && len(source.Values.Columns) > 0 {
+		builder.WriteString(" (")
+		for idx, column := range source.Values.Columns {
+			if idx > 0 {
+				builder.WriteByte(',')
+			}
+			builder.WriteQuoted(column)
+		}
+		builder.WriteByte(')')
+	}
+}
+
+// Build build merge branch
+func (when MergeWhen) Build(builder Builder) {
+	if when.Matched {
+		builder.WriteString("WHEN MATCHED")
+	} else {
+		builder.WriteString("WHEN NOT MATCHED")
+	}
+
+	if when.Condition != nil {
+		builder.WriteString(" AND ")
+		when.Condition.Build(builder)
+	}
+
+	builder.WriteString(" THEN ")
+	switch {
+	case when.Delete:
+		builder.WriteString("DELETE")
+	case len(when.Update) > 0:
+		builder.WriteString("UPDATE SET ")
+		when.Update.Build(builder)
+	case when.Insert != nil:
+		builder.WriteString("INSERT ")
+		when.Insert.Build(builder)
+	default:
+		builder.WriteString("DO NOTHING")
+	}
+}
@@ -0,0 +1,58 @@
+package clause_test
+
+import (
+	"fmt"
+	"testing"
+
+	"gorm.io/gorm/clause"
+)
+
+func TestMerge(t *testing.T) {
+	results := []struct {
+		Clauses []clause.Interface
+		Result  string
+		Vars    []interface{}
+	}{
+		{
+			[]clause.Interface{clause.Merge{
+				Using: clause.MergeSource{Table: clause.Table{Name: "user_stagings", Alias: "s"}},
+				On:    []clause.Expression{clause.Eq{Column: clause.Column{Table: clause.CurrentTable, Name: "id"}, Value: clause.Column{Table: "s", Name: "id"}}},
+				Whens: []clause.MergeWhen{
+					{Matched: true, Condition: clause.Expr{SQL: "s.deleted = ?", Vars: []interface{}{true}}, Delete: true},
+					{Matched: true, Update: clause.Set{{Column: clause.Column{Name: "name"}, Value: clause.Column{Table: "s", Name: "name"}}}},
+					{Insert: &clause.Values{Columns: []clause.Column{{Name: "id"}, {Name: "name"}}, Values: [][]interface{}{{clause.Column{Table: "s", Name: "id"}, clause.Column{Table: "s", Name: "name"}}}}},
+				},
+			}},
+			"MERGE INTO `users` USING `user_stagings` `s` ON `users`.`id` = `s`.`id` WHEN MATCHED AND s.deleted = ? THEN DELETE WHEN MATCHED THEN UPDATE SET `name`=`s`.`name` WHEN NOT MATCHED THEN INSERT (`id`,`name`) VALUES (`s`.`id`,`s`.`name`)",
+			[]interface{}{true},
+		},
+		{
+			[]clause.Interface{clause.Merge{
+				Table: clause.Table{Name: "products", Alias: "p"},
+				Using: clause.MergeSource{Values: &clause.Values{Columns: []clause.Column{{Name: "code"}, {Name: "price"}}, Values: [][]interface{}{{"L1212", 100}, {"L1213", 200}}}, Alias: "v"},
+				On:    []clause.Expression{clause.Expr{SQL: "p.code = v.code"}},
+				Whens: []clause.MergeWhen{
+					{Matched: true, Update: clause.Set{{Column: clause.Column{Name: "price"}, Value: clause.Column{Table: "v", Name: "price"}}}},
+					{},
+				},
+			}},
+			"MERGE INTO `products` `p` USING (VALUES (?,?),(?,?)) AS `v` (`code`,`price`) ON p.code = v.code WHEN MATCHED THEN UPDATE SET `price`=`v`.`price` WHEN NOT MATCHED THEN DO NOTHING",
+			[]interface{}{"L1212", 100, "L1213", 200},
+		},
+		{
+			[]clause.Interface{clause.Merge{
+				Using: clause.MergeSource{Subquery: clause.Expr{SQL: "SELECT * FROM user_stagings WHERE age > ?", Vars: []interface{}{18}}, Alias: "s"},
+				On:    []clause.Expression{clause.Expr{SQL: "users.id = s.id"}},
+				Whens: []clause.MergeWhen{{Matched: true, Delete: true}},
+			}},
+			"MERGE INTO `users` USING (SELECT * FROM user_stagings WHERE age > ?) AS `s` ON users.id = s.id WHEN MATCHED THEN DELETE",
+			[]interface{}{18},
+		},
+	}
+
+	for idx, result := range results {
+		t.Run(fmt.Sprintf("case #%v", idx), func(t *testing.T) {
+			checkBuildClauses(t, result.Clauses, result.Result, result.Vars)
+		})
+	}
+}
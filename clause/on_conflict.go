@@ -57,3 +57,46 @@ func (onConflict OnConflict) Build(builder Builder) {
 func (onConflict OnConflict) MergeClause(clause *Clause) {
 	clause.Expression = onConflict
 }
+
+// Excluded references the value proposed for insertion of the column when updating on conflict, built as
+// `excluded.column` unless the dialect registers the "EXCLUDED" clause builder, e.g: `VALUES(column)` for MySQL
+type Excluded string
+
+// Build build excluded column
+func (excluded Excluded) Build(builder Builder) {
+	if !buildWithClauseBuilder(builder, "EXCLUDED", excluded) {
+		builder.WriteQuoted(Column{Table: "excluded", Name: string(excluded)})
+	}
+}
+
+// AddExcluded assigns the sum of the existing and the excluded value to the column, like `count = users.count + excluded.count`
+func AddExcluded(column string) Assignment {
+	return Assignment{
+		Column: Column{Name: column},
+		Value:  Expr{SQL: "? + ?", Vars: []interface{}{Column{Table: CurrentTable, Name: column}, Excluded(column)}},
+	}
+}
+
+// GreatestExcluded assigns the greater one of the existing and the excluded value to the column, NULL is ignored
+// unless both are NULL, like `score = COALESCE(CASE WHEN excluded.score > users.score THEN excluded.score ELSE users.score END, excluded.score)`
+func GreatestExcluded(column string) Assignment {
+	existing := Column{Table: CurrentTable, Name: column}
+	return Assignment{
+		Column: Column{Name: column},
+		Value: Expr{SQL: "COALESCE(?, ?)", Vars: []interface{}{
+			Case{
+				Whens: []When{{Condition: Expr{SQL: "? > ?", Vars: []interface{}{Excluded(column), existing}}, Then: Excluded(column)}},
+				Else:  existing,
+			},
+			Excluded(column),
+		}},
+	}
+}
+
+// CoalesceExcluded assigns the excluded value to the column unless it is NULL, like `email = COALESCE(excluded.email, users.email)`
+func CoalesceExcluded(column string) Assignment {
+	return Assignment{
+		Column: Column{Name: column},
+		Value:  Expr{SQL: "COALESCE(?, ?)", Vars: []interface{}{Excluded(column), Column{Table: CurrentTable, Name: column}}},
+	}
+}
@@ -1,9 +1,10 @@
 package clause
 
 type OrderByColumn struct {
-	Column  Column
-	Desc    bool
-	Reorder bool
+	Column     Column
+	Desc       bool
+	Reorder    bool
+	Expression Expression // order by the expression instead of Column if set, e.g: clause.Over
 }
 
 type OrderBy struct {
@@ -26,7 +27,11 @@ func (orderBy OrderBy) Build(builder Builder) {
 				builder.WriteByte(',')
 			}
 
-			builder.WriteQuoted(column.Column)
+			if column.Expression != nil {
+				column.Expression.Build(builder)
+			} else {
+				builder.WriteQuoted(column.Column)
+			}
 			if column.Desc {
 				builder.WriteString(" DESC")
 			}
@@ -0,0 +1,61 @@
+package clause
+
+import "strconv"
+
+type SetOperator string
+
+const (
+	Union     SetOperator = "UNION"
+	UnionAll  SetOperator = "UNION ALL"
+	Intersect SetOperator = "INTERSECT"
+	Except    SetOperator = "EXCEPT"
+)
+
+// SetOperation combines the result of the statement with Query using the set operator
+type SetOperation struct {
+	Operator SetOperator
+	Query    Expression
+}
+
+// SetOperations set operations clause, ORDER BY and LIMIT after it are applied to the compound result
+type SetOperations []SetOperation
+
+// Name set operations clause name
+func (operations SetOperations) Name() string {
+	return "SET OPERATIONS"
+}
+
+// Build build set operations clause
+func (operations SetOperations) Build(builder Builder) {
+	for idx, operation := range operations {
+		if idx > 0 {
+			builder.WriteByte(' ')
+		}
+
+		if operation.Operator == "" {
+			builder.WriteString(string(Union))
+		} else {
+			builder.WriteString(string(operation.Operator))
+		}
+
+		// the operand is wrapped as a derived table, so its own ORDER BY and LIMIT are kept and the following operators
+		// couldn't be absorbed by it, sqlite doesn't support parenthesized operands
+		if operation.Query != nil {
+			builder.WriteString(" SELECT * FROM (")
+			operation.Query.Build(builder)
+			builder.WriteString(") AS ")
+			builder.WriteQuoted("set_operation_" + strconv.Itoa(idx+1))
+		}
+	}
+}
+
+// MergeClause merge set operations clauses
+func (operations SetOperations) MergeClause(clause *Clause) {
+	clause.Name = ""
+	if v, ok := clause.Expression.(SetOperations); ok {
+		copiedOperations := make(SetOperations, len(v))
+		copy(copiedOperations, v)
+		operations = append(copiedOperations, operations...)
+	}
+	clause.Expression = operations
+}
@@ -0,0 +1,43 @@
+package clause_test
+
+import (
+	"fmt"
+	"testing"
+
+	"gorm.io/gorm/clause"
+)
+
+func TestSetOperations(t *testing.T) {
+	limit10 := 10
+	results := []struct {
+		Clauses []clause.Interface
+		Result  string
+		Vars    []interface{}
+	}{
+		{
+			[]clause.Interface{clause.Select{}, clause.From{}, clause.SetOperations{{Query: clause.Expr{SQL: "SELECT * FROM admins"}}}},
+			"SELECT * FROM `users` UNION SELECT * FROM (SELECT * FROM admins) AS `set_operation_1`", nil,
+		},
+		{
+			[]clause.Interface{
+				clause.Select{}, clause.From{}, clause.Where{Exprs: []clause.Expression{clause.Eq{Column: "age", Value: 18}}},
+				clause.SetOperations{{Operator: clause.UnionAll, Query: clause.Expr{SQL: "SELECT * FROM users WHERE age > ?", Vars: []interface{}{60}}}},
+				clause.SetOperations{{Operator: clause.Except, Query: clause.Expr{SQL: "SELECT * FROM users WHERE name = ?", Vars: []interface{}{"jinzhu"}}}},
+				clause.OrderBy{Columns: []clause.OrderByColumn{{Column: clause.Column{Name: "name"}}}},
+				clause.Limit{Limit: &limit10},
+			},
+			"SELECT * FROM `users` WHERE `age` = ? UNION ALL SELECT * FROM (SELECT * FROM users WHERE age > ?) AS `set_operation_1` EXCEPT SELECT * FROM (SELECT * FROM users WHERE name = ?) AS `set_operation_2` ORDER BY `name` LIMIT ?",
+			[]interface{}{18, 60, "jinzhu", limit10},
+		},
+		{
+			[]clause.Interface{clause.Select{Columns: []clause.Column{{Name: "id"}}}, clause.From{}, clause.SetOperations{{Operator: clause.Intersect, Query: clause.Expr{SQL: "SELECT user_id FROM orders"}}}},
+			"SELECT `id` FROM `users` INTERSECT SELECT * FROM (SELECT user_id FROM orders) AS `set_operation_1`", nil,
+		},
+	}
+
+	for idx, result := range results {
+		t.Run(fmt.Sprintf("case #%v", idx), func(t *testing.T) {
+			checkBuildClauses(t, result.Clauses, result.Result, result.Vars)
+		})
+	}
+}
@@ -1,8 +1,10 @@
 package clause
 
 type Update struct {
-	Modifier string
-	Table    Table
+	Modifier   string
+	Table      Table
+	Joins      []Join
+	TableHints Expression // hints after the table, like `USE INDEX (idx_name)`
 }
 
 // Name update clause name
@@ -22,6 +24,16 @@ func (update Update) Build(builder Builder) {
 	} else {
 		builder.WriteQuoted(update.Table)
 	}
+
+	if update.TableHints != nil {
+		builder.WriteByte(' ')
+		update.TableHints.Build(builder)
+	}
+
+	for _, join := range update.Joins {
+		builder.WriteByte(' ')
+		join.Build(builder)
+	}
 }
 
 // MergeClause merge update clause
@@ -33,6 +45,9 @@ func (update Update) MergeClause(clause *Clause) {
 		if update.Table.Name == "" {
 			update.Table = v.Table
 		}
+		if len(update.Joins) == 0 {
+			update.Joins = v.Joins
+		}
 	}
 	clause.Expression = update
 }
@@ -25,6 +25,14 @@ func TestUpdate(t *testing.T) {
 			[]clause.Interface{clause.Update{Table: clause.Table{Name: "products"}, Modifier: "LOW_PRIORITY"}},
 			"UPDATE LOW_PRIORITY `products`", nil,
 		},
+		{
+			[]clause.Interface{clause.Update{}, clause.Update{Joins: []clause.Join{{Type: clause.InnerJoin, Table: clause.Table{Name: "stagings"}, ON: clause.Where{Exprs: []clause.Expression{clause.Eq{Column: clause.Column{Table: "stagings", Name: "order_id"}, Value: clause.Column{Table: clause.CurrentTable, Name: "id"}}}}}}}, clause.Set([]clause.Assignment{{Column: clause.Column{Table: clause.CurrentTable, Name: "status"}, Value: "shipped"}})},
+			"UPDATE `users` INNER JOIN `stagings` ON `stagings`.`order_id` = `users`.`id` SET `users`.`status`=?", []interface{}{"shipped"},
+		},
+		{
+			[]clause.Interface{clause.Update{TableHints: clause.UseIndex("idx_name")}, clause.Set([]clause.Assignment{{Column: clause.Column{Name: "name"}, Value: "jinzhu"}})},
+			"UPDATE `users` USE INDEX (`idx_name`) SET `name`=?", []interface{}{"jinzhu"},
+		},
 	}
 
 	for idx, result := range results {
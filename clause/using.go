@@ -0,0 +1,31 @@
+package clause
+
+// Using using clause for delete, like `DELETE FROM users USING companies WHERE ...`
+type Using struct {
+	Tables []Table
+}
+
+// Name using clause name
+func (using Using) Name() string {
+	return "USING"
+}
+
+// Build build using clause
+func (using Using) Build(builder Builder) {
+	for idx, table := range using.Tables {
+		if idx > 0 {
+			builder.WriteByte(',')
+		}
+		builder.WriteQuoted(table)
+	}
+}
+
+// MergeClause merge using clauses
+func (using Using) MergeClause(clause *Clause) {
+	if v, ok := clause.Expression.(Using); ok {
+		tables := make([]Table, len(v.Tables))
+		copy(tables, v.Tables)
+		using.Tables = append(tables, using.Tables...)
+	}
+	clause.Expression = using
+}
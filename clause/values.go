@@ -3,6 +3,7 @@ package clause
 type Values struct {
 	Columns []Column
 	Values  [][]interface{}
+	Query   Expression // insert the rows returned by the query instead of Values, like `INSERT ... SELECT`
 }
 
 // Name from clause name
@@ -22,6 +23,12 @@ func (values Values) Build(builder Builder) {
 		}
 		builder.WriteByte(')')
 
+		if values.Query != nil {
+			builder.WriteByte(' ')
+			values.Query.Build(builder)
+			return
+		}
+
 		builder.WriteString(" VALUES ")
 
 		for idx, value := range values.Values {
@@ -24,6 +24,33 @@ func TestValues(t *testing.T) {
 			"INSERT INTO `users` (`name`,`age`) VALUES (?,?),(?,?)",
 			[]interface{}{"jinzhu", 18, "josh", 1},
 		},
+		{
+			[]clause.Interface{
+				clause.Insert{Table: clause.Table{Name: "user_archives"}},
+				clause.Values{
+					Columns: []clause.Column{{Name: "name"}, {Name: "age"}},
+					Query:   clause.Expr{SQL: "SELECT name, age FROM users WHERE active = ?", Vars: []interface{}{false}},
+				},
+				clause.OnConflict{DoNothing: true},
+			},
+			"INSERT INTO `user_archives` (`name`,`age`) SELECT name, age FROM users WHERE active = ? ON CONFLICT DO NOTHING",
+			[]interface{}{false},
+		},
+		{
+			[]clause.Interface{
+				clause.Insert{},
+				clause.Values{
+					Columns: []clause.Column{{Name: "name"}, {Name: "age"}, {Name: "email"}},
+					Values:  [][]interface{}{{"jinzhu", 18, nil}},
+				},
+				clause.OnConflict{
+					Columns:   []clause.Column{{Name: "name"}},
+					DoUpdates: clause.Set{clause.AddExcluded("age"), clause.GreatestExcluded("score"), clause.CoalesceExcluded("email"), {Column: clause.Column{Name: "role"}, Value: clause.Excluded("role")}},
+				},
+			},
+			"INSERT INTO `users` (`name`,`age`,`email`) VALUES (?,?,?) ON CONFLICT (`name`) DO UPDATE SET `age`=`users`.`age` + `excluded`.`age`,`score`=COALESCE(CASE WHEN `excluded`.`score` > `users`.`score` THEN `excluded`.`score` ELSE `users`.`score` END, `excluded`.`score`),`email`=COALESCE(`excluded`.`email`, `users`.`email`),`role`=`excluded`.`role`",
+			[]interface{}{"jinzhu", 18, nil},
+		},
 	}
 
 	for idx, result := range results {
@@ -0,0 +1,175 @@
+package clause
+
+import "strconv"
+
+type FrameType string
+
+const (
+	FrameRows   FrameType = "ROWS"
+	FrameRange  FrameType = "RANGE"
+	FrameGroups FrameType = "GROUPS"
+)
+
+type FrameBound string
+
+const (
+	UnboundedPreceding FrameBound = "UNBOUNDED PRECEDING"
+	CurrentRow         FrameBound = "CURRENT ROW"
+	UnboundedFollowing FrameBound = "UNBOUNDED FOLLOWING"
+)
+
+// Preceding frame bound with offset rows before current row
+func Preceding(offset int) FrameBound {
+	return FrameBound(strconv.Itoa(offset) + " PRECEDING")
+}
+
+// Following frame bound with offset rows after current row
+func Following(offset int) FrameBound {
+	return FrameBound(strconv.Itoa(offset) + " FOLLOWING")
+}
+
+// Frame window frame, uses BETWEEN when End is not blank
+type Frame struct {
+	Type  FrameType
+	Start FrameBound
+	End   FrameBound
+}
+
+// Build build window frame
+func (frame Frame) Build(builder Builder) {
+	if frame.Type == "" {
+		builder.WriteString(string(FrameRows))
+	} else {
+		builder.WriteString(string(frame.Type))
+	}
+
+	if frame.End != "" {
+		builder.WriteString(" BETWEEN ")
+		builder.WriteString(string(frame.Start))
+		builder.WriteString(" AND ")
+		builder.WriteString(string(frame.End))
+	} else {
+		builder.WriteByte(' ')
+		builder.WriteString(string(frame.Start))
+	}
+}
+
+// Window window specification, Name is used when defining it in the WINDOW clause
+type Window struct {
+	Name        string
+	PartitionBy []Column
+	OrderBy     []OrderByColumn
+	Frame       *Frame
+}
+
+// Build build window specification
+func (window Window) Build(builder Builder) {
+	var written bool
+
+	if len(window.PartitionBy) > 0 {
+		builder.WriteString("PARTITION BY ")
+		for idx, column := range window.PartitionBy {
+			if idx > 0 {
+				builder.WriteByte(',')
+			}
+			builder.WriteQuoted(column)
+		}
+		written = true
+	}
+
+	if len(window.OrderBy) > 0 {
+		if written {
+			builder.WriteByte(' ')
+		}
+		builder.WriteString("ORDER BY ")
+		OrderBy{Columns: window.OrderBy}.Build(builder)
+		written = true
+	}
+
+	if window.Frame != nil {
+		if written {
+			builder.WriteByte(' ')
+		}
+		window.Frame.Build(builder)
+	}
+}
+
+// Over window function expression, like `ROW_NUMBER() OVER (PARTITION BY ... ORDER BY ...)`, the window is
+// referenced by WindowName if it is defined in the WINDOW clause
+type Over struct {
+	Function   Expression
+	WindowName string
+	Window     Window
+	Alias      string
+}
+
+// Build build window function expression
+func (over Over) Build(builder Builder) {
+	if over.Function != nil {
+		over.Function.Build(builder)
+		builder.WriteByte(' ')
+	}
+
+	builder.WriteString("OVER ")
+	if over.WindowName != "" {
+		builder.WriteQuoted(over.WindowName)
+	} else {
+		builder.WriteByte('(')
+		over.Window.Build(builder)
+		builder.WriteByte(')')
+	}
+
+	if over.Alias != "" {
+		builder.WriteString(" AS ")
+		builder.WriteQuoted(over.Alias)
+	}
+}
+
+// Windows named window definitions
+type Windows []Window
+
+// Name window clause name
+func (windows Windows) Name() string {
+	return "WINDOW"
+}
+
+// Build build window clause
+func (windows Windows) Build(builder Builder) {
+	for idx, window := range windows {
+		if idx > 0 {
+			builder.WriteByte(',')
+		}
+
+		builder.WriteQuoted(window.Name)
+		builder.WriteString(" AS (")
+		window.Build(builder)
+		builder.WriteByte(')')
+	}
+}
+
+// MergeClause merge window clauses, a window with the same name will be replaced in place, so the windows declared
+// after it could still reference it
+func (windows Windows) MergeClause(clause *Clause) {
+	if v, ok := clause.Expression.(Windows); ok {
+		merged := make(Windows, len(v), len(v)+len(windows))
+		copy(merged, v)
+
+		for _, window := range windows {
+			replaced := false
+			for idx, w := range merged {
+				if w.Name == window.Name {
+					merged[idx] = window
+					replaced = true
+					break
+				}
+			}
+
+			if !replaced {
+				merged = append(merged, window)
+			}
+		}
+		windows = merged
+	}
+
+	clause.Expression = windows
+}
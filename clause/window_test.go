@@ -0,0 +1,69 @@
+package clause_test
+
+import (
+	"fmt"
+	"testing"
+
+	"gorm.io/gorm/clause"
+)
+
+func TestWindow(t *testing.T) {
+	rowNumber := clause.Over{
+		Function: clause.Expr{SQL: "ROW_NUMBER()"},
+		Window: clause.Window{
+			PartitionBy: []clause.Column{{Name: "company_id"}},
+			OrderBy:     []clause.OrderByColumn{{Column: clause.Column{Name: "age"}, Desc: true}},
+		},
+		Alias: "rn",
+	}
+
+	results := []struct {
+		Clauses []clause.Interface
+		Result  string
+		Vars    []interface{}
+	}{
+		{
+			[]clause.Interface{clause.Select{Expression: clause.Expr{SQL: "name, ?", Vars: []interface{}{rowNumber}}}, clause.From{}},
+			"SELECT name, ROW_NUMBER() OVER (PARTITION BY `company_id` ORDER BY `age` DESC) AS `rn` FROM `users`", nil,
+		},
+		{
+			[]clause.Interface{clause.Select{Expression: clause.Expr{SQL: "?", Vars: []interface{}{clause.Over{
+				Function: clause.Expr{SQL: "SUM(?)", Vars: []interface{}{clause.Column{Name: "amount"}}},
+				Window: clause.Window{
+					OrderBy: []clause.OrderByColumn{{Column: clause.Column{Name: "id"}}},
+					Frame:   &clause.Frame{Start: clause.UnboundedPreceding, End: clause.CurrentRow},
+				},
+			}}}}, clause.From{}},
+			"SELECT SUM(`amount`) OVER (ORDER BY `id` ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW) FROM `users`", nil,
+		},
+		{
+			[]clause.Interface{clause.Select{Expression: clause.Expr{SQL: "?", Vars: []interface{}{clause.Over{
+				Function: clause.Expr{SQL: "AVG(?)", Vars: []interface{}{clause.Column{Name: "age"}}},
+				Window:   clause.Window{Frame: &clause.Frame{Type: clause.FrameRange, Start: clause.Preceding(2), End: clause.Following(1)}},
+			}}}}, clause.From{}},
+			"SELECT AVG(`age`) OVER (RANGE BETWEEN 2 PRECEDING AND 1 FOLLOWING) FROM `users`", nil,
+		},
+		{
+			[]clause.Interface{
+				clause.Select{Expression: clause.Expr{SQL: "?", Vars: []interface{}{clause.Over{Function: clause.Expr{SQL: "RANK()"}, WindowName: "w"}}}},
+				clause.From{},
+				clause.Windows{{Name: "w", PartitionBy: []clause.Column{{Name: "role"}}}, {Name: "v"}},
+				clause.Windows{{Name: "w", PartitionBy: []clause.Column{{Name: "company_id"}}, Frame: &clause.Frame{Type: clause.FrameGroups, Start: clause.CurrentRow}}},
+			},
+			"SELECT RANK() OVER `w` FROM `users` WINDOW `w` AS (PARTITION BY `company_id` GROUPS CURRENT ROW),`v` AS ()", nil,
+		},
+		{
+			[]clause.Interface{clause.Select{}, clause.From{}, clause.OrderBy{Columns: []clause.OrderByColumn{
+				{Column: clause.Column{Name: "name"}},
+				{Expression: clause.Over{Function: clause.Expr{SQL: "ROW_NUMBER()"}, Window: clause.Window{PartitionBy: []clause.Column{{Name: "role"}}}}, Desc: true},
+			}}},
+			"SELECT * FROM `users` ORDER BY `name`,ROW_NUMBER() OVER (PARTITION BY `role`) DESC", nil,
+		},
+	}
+
+	for idx, result := range results {
+		t.Run(fmt.Sprintf("case #%v", idx), func(t *testing.T) {
+			checkBuildClauses(t, result.Clauses, result.Result, result.Vars)
+		})
+	}
+}
@@ -1,3 +1,77 @@
 package clause
 
-type With struct{}
+// With common table expressions clause
+type With struct {
+	Recursive bool
+	CTEs      []CTE
+}
+
+// CTE common table expression, a named subquery that could be referenced like a table
+type CTE struct {
+	Name     string
+	Columns  []string
+	Subquery Expression
+}
+
+// Name with clause name
+func (with With) Name() string {
+	return "WITH"
+}
+
+// Build build with clause
+func (with With) Build(builder Builder) {
+	if with.Recursive {
+		builder.WriteString("RECURSIVE ")
+	}
+
+	for idx, cte := range with.CTEs {
+		if idx > 0 {
+			builder.WriteByte(',')
+		}
+		cte.Build(builder)
+	}
+}
+
+// MergeClause merge with clauses, a CTE with the same name will be replaced in place, so the CTEs declared after it
+// could still reference it
+func (with With) MergeClause(clause *Clause) {
+	if v, ok := clause.Expression.(With); ok {
+		ctes := make([]CTE, len(v.CTEs), len(v.CTEs)+len(with.CTEs))
+		copy(ctes, v.CTEs)
+
+		for _, cte := range with.CTEs {
+			replaced := false
+			for idx, c := range ctes {
+				if c.Name == cte.Name {
+					ctes[idx] = cte
+					replaced = true
+					break
+				}
+			}
+
+			if !replaced {
+				ctes = append(ctes, cte)
+			}
+		}
+
+		with.Recursive = with.Recursive || v.Recursive
+		with.CTEs = ctes
+	}
+
+	clause.Expression = with
+}
+
+// Build build common table expression
+func (cte CTE) Build(builder Builder) {
+	builder.WriteQuoted(cte.Name)
+	if len(cte.Columns) > 0 {
+		builder.WriteByte(' ')
+		builder.WriteQuoted(cte.Columns)
+	}
+
+	builder.WriteString(" AS (")
+	if cte.Subquery != nil {
+		cte.Subquery.Build(builder)
+	}
+	builder.WriteByte(')')
+}
@@ -0,0 +1,43 @@
+package clause_test
+
+import (
+	"fmt"
+	"testing"
+
+	"gorm.io/gorm/clause"
+)
+
+func TestWith(t *testing.T) {
+	results := []struct {
+		Clauses []clause.Interface
+		Result  string
+		Vars    []interface{}
+	}{
+		{
+			[]clause.Interface{clause.With{CTEs: []clause.CTE{{Name: "young_users", Subquery: clause.Expr{SQL: "SELECT * FROM users WHERE age < ?", Vars: []interface{}{18}}}}}, clause.Select{}, clause.From{Tables: []clause.Table{{Name: "young_users"}}}},
+			"WITH `young_users` AS (SELECT * FROM users WHERE age < ?) SELECT * FROM `young_users`", []interface{}{18},
+		},
+		{
+			[]clause.Interface{clause.With{Recursive: true, CTEs: []clause.CTE{{Name: "tree", Columns: []string{"id", "parent_id"}, Subquery: clause.Expr{SQL: "SELECT id, parent_id FROM categories WHERE id = ? UNION ALL SELECT c.id, c.parent_id FROM categories c JOIN tree ON c.parent_id = tree.id", Vars: []interface{}{1}}}}}, clause.Select{}, clause.From{Tables: []clause.Table{{Name: "tree"}}}},
+			"WITH RECURSIVE `tree` (`id`,`parent_id`) AS (SELECT id, parent_id FROM categories WHERE id = ? UNION ALL SELECT c.id, c.parent_id FROM categories c JOIN tree ON c.parent_id = tree.id) SELECT * FROM `tree`", []interface{}{1},
+		},
+		{
+			[]clause.Interface{
+				clause.With{CTEs: []clause.CTE{{Name: "a", Subquery: clause.Expr{SQL: "SELECT 1"}}, {Name: "b", Subquery: clause.Expr{SQL: "SELECT 2"}}}},
+				clause.With{Recursive: true, CTEs: []clause.CTE{{Name: "c", Subquery: clause.Expr{SQL: "SELECT 4"}}, {Name: "a", Subquery: clause.Expr{SQL: "SELECT ?", Vars: []interface{}{3}}}}},
+				clause.Select{}, clause.From{},
+			},
+			"WITH RECURSIVE `a` AS (SELECT ?),`b` AS (SELECT 2),`c` AS (SELECT 4) SELECT * FROM `users`", []interface{}{3},
+		},
+		{
+			[]clause.Interface{clause.With{CTEs: []clause.CTE{{Name: "inactive", Subquery: clause.Expr{SQL: "SELECT id FROM users WHERE active = ?", Vars: []interface{}{false}}}}}, clause.Update{}, clause.Set([]clause.Assignment{{Column: clause.Column{Name: "name"}, Value: "inactive"}}), clause.Where{Exprs: []clause.Expression{clause.Expr{SQL: "id IN (SELECT id FROM inactive)"}}}},
+			"WITH `inactive` AS (SELECT id FROM users WHERE active = ?) UPDATE `users` SET `name`=? WHERE id IN (SELECT id FROM inactive)", []interface{}{false, "inactive"},
+		},
+	}
+
+	for idx, result := range results {
+		t.Run(fmt.Sprintf("case #%v", idx), func(t *testing.T) {
+			checkBuildClauses(t, result.Clauses, result.Result, result.Vars)
+		})
+	}
+}
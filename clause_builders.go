@@ -0,0 +1,190 @@
+package gorm
+
+import (
+	"fmt"
+
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/utils"
+)
+
+// The clause builders of the expressions without portable syntax, they are not registered by default, the dialector
+// or users could opt in with RegisterClauseBuilders, e.g:
+//
+//	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+//	gorm.RegisterClauseBuilders(db, gorm.PostgresClauseBuilders)
+var (
+	// MySQLClauseBuilders builds Excluded with `VALUES(column)` for `ON DUPLICATE KEY UPDATE`, supports the optimizer
+	// and index hints, builds ROLLUP with `WITH ROLLUP` and rejects CUBE and GROUPING SETS
+	MySQLClauseBuilders = map[string]clause.ClauseBuilder{
+		"EXCLUDED": valuesExcludedClauseBuilder,
+		"HINT":     mysqlHintClauseBuilder,
+		"ROLLUP":   clause.WithRollup,
+		"GROUP BY": groupByClauseBuilder("CUBE", "GROUPING SETS"),
+	}
+
+	// PostgresClauseBuilders builds Regex with `~` and ILike with `ILIKE`, supports the merge statement
+	PostgresClauseBuilders = map[string]clause.ClauseBuilder{
+		"REGEXP":     operatorClauseBuilder(" ~ "),
+		"NOT REGEXP": operatorClauseBuilder(" !~ "),
+		"ILIKE":      operatorClauseBuilder(" ILIKE "),
+		"NOT ILIKE":  operatorClauseBuilder(" NOT ILIKE "),
+		"MERGE":      mergeClauseBuilder,
+	}
+
+	// SQLiteClauseBuilders rewrites Any and All to IN and NOT IN, as sqlite has no quantified comparisons, rejects
+	// ROLLUP, CUBE and GROUPING SETS
+	SQLiteClauseBuilders = map[string]clause.ClauseBuilder{
+		"ANY":      quantifiedInClauseBuilder,
+		"ALL":      quantifiedInClauseBuilder,
+		"GROUP BY": groupByClauseBuilder("ROLLUP", "CUBE", "GROUPING SETS"),
+	}
+
+	// SQLServerClauseBuilders builds Regex with `REGEXP_LIKE` and terminates the merge statement with semicolon
+	SQLServerClauseBuilders = map[string]clause.ClauseBuilder{
+		"REGEXP":     functionClauseBuilder("REGEXP_LIKE("),
+		"NOT REGEXP": functionClauseBuilder("NOT REGEXP_LIKE("),
+		"MERGE":      sqlserverMergeClauseBuilder,
+	}
+)
+
+// RegisterClauseBuilders registers the clause builders for the db, the builders already registered by the dialector
+// or the config are kept
+func RegisterClauseBuilders(db *DB, builders map[string]clause.ClauseBuilder) {
+	for name, builder := range builders {
+		if _, ok := db.ClauseBuilders[name]; !ok {
+			db.ClauseBuilders[name] = builder
+		}
+	}
+}
+
+// operatorClauseBuilder builds the column and value with the operator
+func operatorClauseBuilder(operator string) clause.ClauseBuilder {
+	return func(c clause.Clause, builder clause.Builder) {
+		var expr clause.Eq
+		switch v := c.Expression.(type) {
+		case clause.Regex:
+			expr = clause.Eq(v)
+		case clause.ILike:
+			expr = clause.Eq(v)
+		default:
+			builder.AddError(fmt.Errorf("%w: %s", clause.ErrUnsupportedExpression, c.Name))
+			return
+		}
+
+		builder.WriteQuoted(expr.Column)
+		builder.WriteString(operator)
+		builder.AddVar(builder, expr.Value)
+	}
+}
+
+// functionClauseBuilder builds the column and value as the arguments of the function
+func functionClauseBuilder(function string) clause.ClauseBuilder {
+	return func(c clause.Clause, builder clause.Builder) {
+		regex, ok := c.Expression.(clause.Regex)
+		if !ok {
+			builder.AddError(fmt.Errorf("%w: %s", clause.ErrUnsupportedExpression, c.Name))
+			return
+		}
+
+		builder.WriteString(function)
+		builder.WriteQuoted(regex.Column)
+		builder.WriteByte(',')
+		builder.AddVar(builder, regex.Value)
+		builder.WriteByte(')')
+	}
+}
+
+// quantifiedInClauseBuilder rewrites `= ANY` to `IN` and `<> ALL` to `NOT IN` for the dialects without ANY and ALL,
+// other comparisons couldn't be rewritten
+func quantifiedInClauseBuilder(c clause.Clause, builder clause.Builder) {
+	var (
+		expr     clause.Any
+		operator string
+	)
+
+	switch v := c.Expression.(type) {
+	case clause.Any:
+		if expr = v; v.Operator == "" || v.Operator == "=" {
+			operator = " IN ("
+		}
+	case clause.All:
+		if expr = clause.Any(v); v.Operator == "<>" || v.Operator == "!=" {
+			operator = " NOT IN ("
+		}
+	}
+
+	if operator == "" {
+		builder.AddError(fmt.Errorf("%w: %s with operator %q", clause.ErrUnsupportedExpression, c.Name, expr.Operator))
+		return
+	}
+
+	builder.WriteQuoted(expr.Column)
+	builder.WriteString(operator)
+	builder.AddVar(builder, expr.Subquery)
+	builder.WriteByte(')')
+}
+
+// groupByClauseBuilder builds the group by clause, returns error if the grouping modifiers unsupported by the dialect
+// are used
+func groupByClauseBuilder(unsupported ...string) clause.ClauseBuilder {
+	return func(c clause.Clause, builder clause.Builder) {
+		if groupBy, ok := c.Expression.(clause.GroupBy); ok {
+			for _, modifier := range unsupported {
+				if (modifier == "ROLLUP" && len(groupBy.Rollup) > 0) || (modifier == "CUBE" && len(groupBy.Cube) > 0) ||
+					(modifier == "GROUPING SETS" && len(groupBy.GroupingSets) > 0) {
+					builder.AddError(fmt.Errorf("%w: %s", clause.ErrUnsupportedExpression, modifier))
+					return
+				}
+			}
+		}
+
+		c.Build(builder)
+	}
+}
+
+// valuesExcludedClauseBuilder references the value proposed for insertion with `VALUES(column)`
+func valuesExcludedClauseBuilder(c clause.Clause, builder clause.Builder) {
+	if excluded, ok := c.Expression.(clause.Excluded); ok {
+		builder.WriteString("VALUES(")
+		builder.WriteQuoted(clause.Column{Name: string(excluded)})
+		builder.WriteByte(')')
+	}
+}
+
+// mysqlHintClauseBuilder builds the hints as they are, the index hints are skipped in delete statements, as mysql
+// doesn't support them in single-table delete
+func mysqlHintClauseBuilder(c clause.Clause, builder clause.Builder) {
+	hint, ok := c.Expression.(clause.Hint)
+	if !ok {
+		return
+	}
+
+	switch hint.Type {
+	case clause.HintUseIndex, clause.HintForceIndex, clause.HintIgnoreIndex:
+		if stmt, ok := builder.(*Statement); ok && utils.Contains(stmt.BuildClauses, "DELETE") {
+			return
+		}
+	}
+	hint.Build(builder)
+}
+
+// mergeClauseBuilder builds the merge statement as it is, registered by the dialects supporting merge
+func mergeClauseBuilder(c clause.Clause, builder clause.Builder) {
+	c.Build(builder)
+}
+
+// sqlserverMergeClauseBuilder terminates the merge statement with semicolon as required by sqlserver, which doesn't
+// support the branches doing nothing
+func sqlserverMergeClauseBuilder(c clause.Clause, builder clause.Builder) {
+	if merge, ok := c.Expression.(clause.Merge); ok {
+		for _, when := range merge.Whens {
+			if !when.Delete && len(when.Update) == 0 && when.Insert == nil {
+				builder.AddError(fmt.Errorf("%w: merge branch doing nothing", clause.ErrUnsupportedExpression))
+				return
+			}
+		}
+	}
+
+	c.Build(builder)
+	builder.WriteByte(';')
+}
@@ -0,0 +1,57 @@
+package gorm
+
+import (
+	"fmt"
+
+	"gorm.io/gorm/schema"
+)
+
+// ReencryptInBatches re-encrypts the columns of schema.EncryptedSerializer with the current key of their keyrings in
+// batches after rotating keys, the rows are decrypted with the keys of their ciphertexts and written back without hooks
+// in a transaction per batch, the table, conditions and scopes of db are kept, dest should be a pointer to slice of the
+// model
+//
+//	gorm.ReencryptInBatches(db, &[]User{}, 100)
+//	gorm.ReencryptInBatches(db.Table("archived_users"), &[]User{}, 100)
+func ReencryptInBatches(db *DB, dest interface{}, batchSize int) (tx *DB) {
+	tx = db.getInstance()
+	if batchSize <= 0 {
+		tx.AddError(fmt.Errorf("%w: batch size %d should be greater than 0", ErrInvalidData, batchSize))
+		return tx
+	}
+
+	if err := tx.Statement.Parse(dest); err != nil {
+		tx.AddError(err)
+		return tx
+	}
+
+	var columns []string
+	for _, field := range tx.Statement.Schema.Fields {
+		switch field.Serializer.(type) {
+		case schema.EncryptedSerializer, *schema.EncryptedSerializer:
+			if field.DBName != "" && field.Updatable {
+				columns = append(columns, field.DBName)
+			}
+		}
+	}
+
+	if len(columns) == 0 {
+		return tx
+	}
+
+	var (
+		rowsAffected int64
+		// keeps the table, conditions and scopes of the statement before querying in batches
+		updateDB = tx.Session(&Session{SkipHooks: true})
+	)
+
+	result := tx.FindInBatches(dest, batchSize, func(batchTx *DB, batch int) error {
+		return updateDB.Transaction(func(updateTx *DB) error {
+			updated := updateTx.Select(columns).UpdatesInBatches(dest, batchSize)
+			rowsAffected += updated.RowsAffected
+			return updated.Error
+		})
+	})
+	result.RowsAffected = rowsAffected
+	return result
+}
@@ -51,4 +51,6 @@ var (
 	ErrForeignKeyViolated = errors.New("violates foreign key constraint")
 	// ErrCheckConstraintViolated occurs when there is a check constraint violation
 	ErrCheckConstraintViolated = errors.New("violates check constraint")
+	// ErrInvalidEnumValue occurs when the value of enum field isn't one of the enum values
+	ErrInvalidEnumValue = errors.New("invalid enum value")
 )
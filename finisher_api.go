@@ -81,6 +81,112 @@ func (db *DB) CreateInBatches(value interface{}, batchSize int) (tx *DB) {
 	return
 }
 
+// CreateFrom inserts the rows returned by subquery into the model's table with `INSERT INTO ... SELECT ...`
+//
+// The inserted columns are mapped through the model's schema from the fields selected by Select, or the fields
+// selected by the subquery, all creatable fields are inserted and selected from the subquery if neither is specified,
+// the tracking time fields like CreatedAt and UpdatedAt are set to the current time then. Hooks are skipped as there
+// are no values in memory, rows returned by RETURNING are scanned into the model.
+//
+//	// archive inactive users
+//	db.Model(&UserArchive{}).CreateFrom(db.Model(&User{}).Where("active = ?", false))
+//	// copy name and age only
+//	db.Model(&UserArchive{}).Select("Name", "Age").CreateFrom(db.Model(&User{}).Select("name", "age"))
+func (db *DB) CreateFrom(subquery *DB) (tx *DB) {
+	tx = db.getInstance()
+	if tx.Statement.Model == nil {
+		tx.Statement.Model = tx.Statement.Dest
+	} else if tx.Statement.Dest == nil {
+		tx.Statement.Dest = tx.Statement.Model
+	}
+
+	if tx.Statement.Model == nil {
+		tx.AddError(ErrModelValueRequired)
+		return
+	}
+
+	if err := tx.Statement.Parse(tx.Statement.Model); err != nil {
+		tx.AddError(err)
+		return
+	}
+
+	selects := tx.Statement.Selects
+	if len(selects) == 0 {
+		selects = subquery.Statement.Selects
+	}
+
+	var columns []clause.Column
+	if len(selects) == 0 {
+		var (
+			placeholders = make([]string, 0, len(tx.Statement.Schema.DBNames))
+			values       = make([]interface{}, 0, len(tx.Statement.Schema.DBNames))
+			row          = reflect.New(tx.Statement.Schema.ModelType).Elem()
+			now          = tx.NowFunc()
+		)
+
+		for _, dbName := range tx.Statement.Schema.DBNames {
+			field := tx.Statement.Schema.FieldsByDBName[dbName]
+			if field.Creatable && !field.AutoIncrement {
+				columns = append(columns, clause.Column{Name: dbName})
+				placeholders = append(placeholders, "?")
+
+				// the tracking time of the inserted rows is the current time, instead of the time of the source rows
+				if field.AutoCreateTime > 0 || field.AutoUpdateTime > 0 {
+					if err := field.Set(tx.Statement.Context, row, now); err != nil {
+						tx.AddError(err)
+						return
+					}
+					value, _ := field.ValueOf(tx.Statement.Context, row)
+					values = append(values, value)
+				} else {
+					values = append(values, clause.Column{Name: dbName})
+				}
+			}
+		}
+		subquery = subquery.Session(&Session{}).Select(strings.Join(placeholders, ","), values...)
+	} else {
+		for _, name := range selects {
+			field := tx.Statement.Schema.LookUpField(name)
+			if field == nil {
+				tx.AddError(fmt.Errorf("%w: %s is not found in %s", ErrInvalidField, name, tx.Statement.Schema.Name))
+				return
+			}
+			columns = append(columns, clause.Column{Name: field.DBName})
+		}
+	}
+
+	tx.Statement.SkipHooks = true
+	tx.Statement.AddClause(clause.Values{Columns: columns, Query: clause.Expr{SQL: "?", Vars: []interface{}{subquery}}})
+	return tx.callbacks.Create().Execute(tx)
+}
+
+// Merge merges the source into the table of the model with the matched and not matched branches, each branch
+// updates, inserts or deletes the rows, ErrUnsupportedDriver is returned unless the dialect registers the "MERGE"
+// clause builder, e.g: gorm.PostgresClauseBuilders. Soft delete is not applied, delete branches delete the rows
+// permanently, hooks are skipped as there are no values in memory
+//
+//	// upsert users from staging table, delete the users marked as deleted
+//	db.Model(&User{}).Merge(clause.Merge{
+//		Using: clause.MergeSource{Table: clause.Table{Name: "user_stagings", Alias: "s"}},
+//		On:    []clause.Expression{clause.Expr{SQL: "users.id = s.id"}},
+//		Whens: []clause.MergeWhen{
+//			{Matched: true, Condition: clause.Expr{SQL: "s.deleted"}, Delete: true},
+//			{Matched: true, Update: clause.Set{{Column: clause.Column{Name: "name"}, Value: clause.Column{Table: "s", Name: "name"}}}},
+//			{Insert: &clause.Values{Columns: []clause.Column{{Name: "id"}, {Name: "name"}}, Values: [][]interface{}{{clause.Column{Table: "s", Name: "id"}, clause.Column{Table: "s", Name: "name"}}}}},
+//		},
+//	})
+func (db *DB) Merge(merge clause.Merge) (tx *DB) {
+	tx = db.getInstance()
+	if tx.Statement.Model == nil && tx.Statement.Dest == nil && tx.Statement.Table == "" && merge.Table.Name == "" {
+		tx.AddError(ErrModelValueRequired)
+		return
+	}
+
+	tx.Statement.SkipHooks = true
+	tx.Statement.AddClause(merge)
+	return tx.callbacks.Merge().Execute(tx)
+}
+
 // Save updates value in database. If value doesn't contain a matching primary key, value is inserted.
 func (db *DB) Save(value interface{}) (tx *DB) {
 	tx = db.getInstance()
@@ -128,9 +234,7 @@ func (db *DB) Save(value interface{}) (tx *DB) {
 
 // First finds the first record ordered by primary key, matching given conditions conds
 func (db *DB) First(dest interface{}, conds ...interface{}) (tx *DB) {
-	tx = db.Limit(1).Order(clause.OrderByColumn{
-		Column: clause.Column{Table: clause.CurrentTable, Name: clause.PrimaryKey},
-	})
+	tx = db.Limit(1).Order(clause.OrderByColumn{Column: primaryKeyOrderColumn(db.Statement)})
 	if len(conds) > 0 {
 		if exprs := tx.Statement.BuildCondition(conds[0], conds[1:]...); len(exprs) > 0 {
 			tx.Statement.AddClause(clause.Where{Exprs: exprs})
@@ -141,6 +245,15 @@ func (db *DB) First(dest interface{}, conds ...interface{}) (tx *DB) {
 	return tx.callbacks.Query().Execute(tx)
 }
 
+// primaryKeyOrderColumn returns the implicit order column of First and Last, the compound result of set operations
+// could only be ordered by its unqualified output columns
+func primaryKeyOrderColumn(stmt *Statement) clause.Column {
+	if _, ok := stmt.Clauses["SET OPERATIONS"]; ok {
+		return clause.Column{Name: clause.PrimaryKey}
+	}
+	return clause.Column{Table: clause.CurrentTable, Name: clause.PrimaryKey}
+}
+
 // Take finds the first record returned by the database in no specified order, matching given conditions conds
 func (db *DB) Take(dest interface{}, conds ...interface{}) (tx *DB) {
 	tx = db.Limit(1)
@@ -157,7 +270,7 @@ func (db *DB) Take(dest interface{}, conds ...interface{}) (tx *DB) {
 // Last finds the last record ordered by primary key, matching given conditions conds
 func (db *DB) Last(dest interface{}, conds ...interface{}) (tx *DB) {
 	tx = db.Limit(1).Order(clause.OrderByColumn{
-		Column: clause.Column{Table: clause.CurrentTable, Name: clause.PrimaryKey},
+		Column: primaryKeyOrderColumn(db.Statement),
 		Desc:   true,
 	})
 	if len(conds) > 0 {
@@ -182,12 +295,63 @@ func (db *DB) Find(dest interface{}, conds ...interface{}) (tx *DB) {
 	return tx.callbacks.Query().Execute(tx)
 }
 
-// FindInBatches finds all records in batches of batchSize
+// FindInBatches finds all records in batches of batchSize, the batches are ordered by the primary keys
 func (db *DB) FindInBatches(dest interface{}, batchSize int, fc func(tx *DB, batch int) error) *DB {
+	return db.findInBatches(dest, batchSize, nil, fc)
+}
+
+// FindInBatchesByKeyset finds all records in batches of batchSize ordered by the keyset columns, the primary keys are
+// appended to the columns if not included, the order of the statement should not be specified as it precedes the
+// keyset order
+//
+//	db.FindInBatchesByKeyset(&users, 100, []clause.OrderByColumn{{Column: clause.Column{Name: "CreatedAt"}}}, func(tx *gorm.DB, batch int) error {
+//		return nil
+//	})
+func (db *DB) FindInBatchesByKeyset(dest interface{}, batchSize int, columns []clause.OrderByColumn, fc func(tx *DB, batch int) error) *DB {
+	if len(columns) == 0 {
+		tx := db.getInstance()
+		tx.AddError(fmt.Errorf("%w: keyset columns required", ErrInvalidData))
+		return tx
+	}
+	return db.findInBatches(dest, batchSize, columns, fc)
+}
+
+func (db *DB) findInBatches(dest interface{}, batchSize int, columns []clause.OrderByColumn, fc func(tx *DB, batch int) error) *DB {
+	var (
+		orderColumns = []clause.OrderByColumn{{Column: clause.Column{Table: clause.CurrentTable, Name: clause.PrimaryKey}}}
+		keysetFields []*schema.Field
+	)
+
+	model := db.Statement.Model
+	if model == nil {
+		model = dest
+	}
+
+	// batches with keyset columns, or composite primary keys
+	if stmt := (&Statement{DB: db}); stmt.Parse(model) == nil {
+		if len(columns) > 0 || (stmt.Schema.PrioritizedPrimaryField == nil && len(stmt.Schema.PrimaryFields) > 1) {
+			var err error
+			if keysetFields, orderColumns, err = paginationColumns(stmt.Schema, columns); err != nil {
+				tx := db.getInstance()
+				tx.AddError(err)
+				return tx
+			}
+
+			// the rows after the last one of the batch are not the next batch if ordered by other columns first
+			if _, ok := db.Statement.Clauses["ORDER BY"]; ok {
+				tx := db.getInstance()
+				tx.AddError(fmt.Errorf("%w: the order couldn't be specified when finding in batches by keyset", ErrInvalidData))
+				return tx
+			}
+		}
+	} else if len(columns) > 0 {
+		tx := db.getInstance()
+		tx.AddError(ErrModelValueRequired)
+		return tx
+	}
+
 	var (
-		tx = db.Order(clause.OrderByColumn{
-			Column: clause.Column{Table: clause.CurrentTable, Name: clause.PrimaryKey},
-		}).Session(&Session{})
+		tx           = db.Clauses(clause.OrderBy{Columns: orderColumns}).Session(&Session{})
 		queryDB      = tx
 		rowsAffected int64
 		batch        int
@@ -238,12 +402,31 @@ func (db *DB) FindInBatches(dest interface{}, batchSize int, fc func(tx *DB, bat
 
 		// Optimize for-break
 		resultsValue := reflect.Indirect(reflect.ValueOf(dest))
+		lastValue := resultsValue.Index(resultsValue.Len() - 1)
+		if len(keysetFields) > 0 {
+			values := make([]interface{}, len(keysetFields))
+			for idx, field := range keysetFields {
+				value, zero := field.ValueOf(tx.Statement.Context, lastValue)
+				if zero && field.PrimaryKey {
+					tx.AddError(ErrPrimaryKeyRequired)
+					break
+				}
+				values[idx] = value
+			}
+
+			if tx.Error != nil {
+				break
+			}
+			queryDB = tx.Clauses(keysetCondition(orderColumns, values))
+			continue
+		}
+
 		if result.Statement.Schema.PrioritizedPrimaryField == nil {
 			tx.AddError(ErrPrimaryKeyRequired)
 			break
 		}
 
-		primaryValue, zero := result.Statement.Schema.PrioritizedPrimaryField.ValueOf(tx.Statement.Context, resultsValue.Index(resultsValue.Len()-1))
+		primaryValue, zero := result.Statement.Schema.PrioritizedPrimaryField.ValueOf(tx.Statement.Context, lastValue)
 		if zero {
 			tx.AddError(ErrPrimaryKeyRequired)
 			break
@@ -255,6 +438,118 @@ func (db *DB) FindInBatches(dest interface{}, batchSize int, fc func(tx *DB, bat
 	return tx
 }
 
+// Paginate finds a page of records with keyset pagination, the order of the statement is replaced by the columns
+// of the pagination, and the cursors of the next and previous pages are set to the pagination
+//
+//	pagination := gorm.Pagination{Limit: 20, Columns: []clause.OrderByColumn{{Column: clause.Column{Name: "CreatedAt"}, Desc: true}}}
+//	db.Where("active = ?", true).Paginate(&users, &pagination)
+//	// the next page
+//	pagination.Cursor = pagination.Next
+//	db.Where("active = ?", true).Paginate(&users, &pagination)
+func (db *DB) Paginate(dest interface{}, pagination *Pagination) (tx *DB) {
+	tx = db.getInstance()
+	if pagination == nil {
+		tx.AddError(fmt.Errorf("%w: pagination required", ErrInvalidData))
+		return
+	}
+
+	if pagination.Limit <= 0 {
+		tx.AddError(fmt.Errorf("%w: pagination limit should be greater than zero", ErrInvalidData))
+		return
+	}
+
+	if destValue := reflect.ValueOf(dest); destValue.Kind() != reflect.Ptr || destValue.Elem().Kind() != reflect.Slice {
+		tx.AddError(fmt.Errorf("%w: dest should be a pointer to slice, got %T", ErrInvalidData, dest))
+		return
+	}
+
+	model := tx.Statement.Model
+	if model == nil {
+		model = dest
+	}
+
+	if err := tx.Statement.Parse(model); err != nil {
+		tx.AddError(err)
+		return
+	}
+
+	fields, columns, err := paginationColumns(tx.Statement.Schema, pagination.Columns)
+	if err != nil {
+		tx.AddError(err)
+		return
+	}
+
+	var (
+		backward bool
+		keys     = cursorKeys(columns)
+	)
+	if pagination.Cursor != "" {
+		var values []interface{}
+		if values, backward, err = decodeCursor(pagination.Cursor, keys, fields); err != nil {
+			tx.AddError(err)
+			return
+		}
+
+		if backward {
+			for idx := range columns {
+				columns[idx].Desc = !columns[idx].Desc
+			}
+		}
+		tx.Statement.AddClause(clause.Where{Exprs: []clause.Expression{keysetCondition(columns, values)}})
+	}
+
+	columns[0].Reorder = true
+	tx.Statement.AddClause(clause.OrderBy{Columns: columns})
+	tx.Statement.AddClause(clause.Limit{Limit: &[]int{pagination.Limit + 1}[0]})
+	if tx = tx.Find(dest); tx.Error != nil {
+		return
+	}
+
+	// the values of the dest are parsed as the dest might be a different struct of the model
+	destStmt := &Statement{DB: tx, Context: tx.Statement.Context}
+	if err := destStmt.Parse(dest); err != nil {
+		tx.AddError(err)
+		return
+	}
+
+	destFields := make([]*schema.Field, len(fields))
+	for idx, field := range fields {
+		if destFields[idx] = destStmt.Schema.LookUpField(field.DBName); destFields[idx] == nil {
+			tx.AddError(fmt.Errorf("%w: %s is not found in %s", ErrInvalidField, field.DBName, destStmt.Schema.Name))
+			return
+		}
+	}
+
+	results := reflect.Indirect(reflect.ValueOf(dest))
+	hasMore := results.Len() > pagination.Limit
+	if hasMore {
+		results.Set(results.Slice(0, pagination.Limit))
+	}
+
+	if backward {
+		swap := reflect.Swapper(results.Interface())
+		for i, j := 0, results.Len()-1; i < j; i, j = i+1, j-1 {
+			swap(i, j)
+		}
+	}
+
+	pagination.Next, pagination.Prev = "", ""
+	if size := results.Len(); size > 0 {
+		if hasMore || backward {
+			pagination.Next, err = encodeCursor(tx.Statement, keys, destFields, results.Index(size-1), false)
+			tx.AddError(err)
+		}
+
+		if (hasMore && backward) || (!backward && pagination.Cursor != "") {
+			pagination.Prev, err = encodeCursor(tx.Statement, keys, destFields, results.Index(0), true)
+			tx.AddError(err)
+		}
+	}
+
+	tx.RowsAffected = int64(results.Len())
+	return tx
+}
+
 func (db *DB) assignInterfacesToValue(values ...interface{}) {
 	for _, value := range values {
 		switch v := value.(type) {
@@ -317,9 +612,7 @@ func (db *DB) assignInterfacesToValue(values ...interface{}) {
 //	db.Where(User{Name: "jinzhu"}).Assign(User{Email: "fake@fake.org"}).FirstOrInit(&user)
 //	// user -> User{Name: "jinzhu", Age: 20, Email: "fake@fake.org"}
 func (db *DB) FirstOrInit(dest interface{}, conds ...interface{}) (tx *DB) {
-	queryTx := db.Limit(1).Order(clause.OrderByColumn{
-		Column: clause.Column{Table: clause.CurrentTable, Name: clause.PrimaryKey},
-	})
+	queryTx := db.Limit(1).Order(clause.OrderByColumn{Column: primaryKeyOrderColumn(db.Statement)})
 
 	if tx = queryTx.Find(dest, conds...); tx.RowsAffected == 0 {
 		if c, ok := tx.Statement.Clauses["WHERE"]; ok {
@@ -357,9 +650,7 @@ func (db *DB) FirstOrInit(dest interface{}, conds ...interface{}) (tx *DB) {
 //	// result.RowsAffected -> 1
 func (db *DB) FirstOrCreate(dest interface{}, conds ...interface{}) (tx *DB) {
 	tx = db.getInstance()
-	queryTx := db.Session(&Session{}).Limit(1).Order(clause.OrderByColumn{
-		Column: clause.Column{Table: clause.CurrentTable, Name: clause.PrimaryKey},
-	})
+	queryTx := db.Session(&Session{}).Limit(1).Order(clause.OrderByColumn{Column: primaryKeyOrderColumn(db.Statement)})
 
 	result := queryTx.Find(dest, conds...)
 	if result.Error != nil {
@@ -423,6 +714,82 @@ func (db *DB) Updates(values interface{}) (tx *DB) {
 	return tx.callbacks.Update().Execute(tx)
 }
 
+// UpdatesInBatches updates the rows of the slice with their own values in batches, each batch is updated in one
+// statement keyed by primary keys, all updatable fields are updated unless restricted by Select/Omit
+//
+//	// UPDATE users SET name = CASE WHEN id = 1 THEN 'jinzhu' WHEN id = 2 THEN 'josh' ELSE name END, ... WHERE id IN (1,2)
+//	// or for the dialects updating from other tables:
+//	// UPDATE users SET name = batch_rows.name, ... FROM (VALUES (1,'jinzhu',18),(2,'josh',20)) AS batch_rows(id,name,age) WHERE users.id = batch_rows.id
+//	db.Select("Name", "Age").UpdatesInBatches(&users, 100)
+func (db *DB) UpdatesInBatches(values interface{}, batchSize int) (tx *DB) {
+	reflectValue := reflect.Indirect(reflect.ValueOf(values))
+
+	switch reflectValue.Kind() {
+	case reflect.Slice, reflect.Array:
+		var rowsAffected int64
+		tx = db.getInstance()
+
+		if batchSize <= 0 {
+			tx.AddError(fmt.Errorf("%w: batch size %d should be greater than 0", ErrInvalidData, batchSize))
+			return
+		}
+
+		if reflectValue.Kind() == reflect.Array && !reflectValue.CanAddr() {
+			// arrays passed by value are copied to be sliced
+			array := reflect.New(reflectValue.Type()).Elem()
+			array.Set(reflectValue)
+			reflectValue = array
+		}
+
+		batchType := reflect.SliceOf(reflectValue.Type().Elem())
+		reflectLen := reflectValue.Len()
+
+		callFc := func(tx *DB) error {
+			for i := 0; i < reflectLen; i += batchSize {
+				ends := i + batchSize
+				if ends > reflectLen {
+					ends = reflectLen
+				}
+
+				subtx := tx.getInstance()
+				batchSlice := reflect.New(batchType)
+				batchSlice.Elem().Set(reflectValue.Slice(i, ends))
+				// the rows are the updating values of themselves
+				subtx.Statement.UpdatingInBatches = true
+				subtx.Statement.Model = batchSlice.Interface()
+				subtx.Statement.Dest = subtx.Statement.Model
+
+				subtx.callbacks.Update().Execute(subtx)
+
+				if subtx.Error != nil {
+					return subtx.Error
+				}
+
+				resultSlice := reflect.Indirect(batchSlice)
+				for j := 0; j < resultSlice.Len(); j++ {
+					reflectValue.Index(i + j).Set(resultSlice.Index(j))
+				}
+
+				rowsAffected += subtx.RowsAffected
+			}
+			return nil
+		}
+
+		if tx.SkipDefaultTransaction || reflectLen <= batchSize {
+			tx.AddError(callFc(tx.Session(&Session{})))
+		} else {
+			tx.AddError(tx.Transaction(callFc))
+		}
+
+		tx.RowsAffected = rowsAffected
+	default:
+		tx = db.getInstance()
+		tx.Statement.Dest = values
+		tx = tx.callbacks.Update().Execute(tx)
+	}
+	return
+}
+
 func (db *DB) UpdateColumn(column string, value interface{}) (tx *DB) {
 	tx = db.getInstance()
 	tx.Statement.Dest = map[string]interface{}{column: value}
@@ -452,6 +819,12 @@ func (db *DB) Delete(value interface{}, conds ...interface{}) (tx *DB) {
 }
 
 func (db *DB) Count(count *int64) (tx *DB) {
+	if _, ok := db.Statement.Clauses["SET OPERATIONS"]; ok {
+		// count the rows of the compound result, as the set operations are applied after the selected count(*)
+		compound := db.Session(&Session{})
+		return db.Session(&Session{NewDB: true}).Table("(?) AS ?", compound, clause.Table{Name: "compound"}).Count(count)
+	}
+
 	tx = db.getInstance()
 	if tx.Statement.Model == nil {
 		tx.Statement.Model = tx.Statement.Dest
@@ -608,6 +981,65 @@ func (db *DB) ScanRows(rows *sql.Rows, dest interface{}) error {
 	return tx.Error
 }
 
+// Iterate returns an iterator that scans the rows into dest one by one, yields the index of the row after scanning,
+// the rows are closed when all rows are scanned or the loop breaks, it is compatible with iter.Seq2[int, error], e.g:
+//
+//	var user User
+//	for _, err := range db.Model(&User{}).Where("active = ?", true).Iterate(&user) {
+//		if err != nil {
+//			return err
+//		}
+//		// export user
+//	}
+func (db *DB) Iterate(dest interface{}) func(yield func(int, error) bool) {
+	return func(yield func(int, error) bool) {
+		tx := db.getInstance()
+		if tx.Statement.Model == nil {
+			tx.Statement.Model = dest
+		}
+
+		tx.eachRow(func() interface{} { return dest }, func(_ interface{}, idx int, err error) bool {
+			return yield(idx, err)
+		})
+	}
+}
+
+// eachRow scans the rows one by one into the value returned by newDest, yields the value after scanning, the value
+// is nil if the rows failed to be queried
+func (db *DB) eachRow(newDest func() interface{}, yield func(dest interface{}, idx int, err error) bool) {
+	tx := db.getInstance()
+	rows, err := tx.Rows()
+	if err != nil {
+		yield(nil, 0, err)
+		return
+	}
+	defer rows.Close()
+
+	var (
+		scanner *rowScanner
+		idx     int
+	)
+	for ; rows.Next(); idx++ {
+		dest := newDest()
+		if scanner == nil {
+			scanner = tx.newRowScanner(rows, dest)
+		}
+
+		if err := scanner.scan(rows, dest); err != nil {
+			yield(dest, idx, err)
+			return
+		}
+
+		if !yield(dest, idx, nil) {
+			return
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		yield(nil, idx, err)
+	}
+}
+
 // Connection uses a db connection to execute an arbitrary number of commands in fc. When finished, the connection is
 // returned to the connection pool.
 func (db *DB) Connection(fc func(tx *DB) error) (err error) {
@@ -3,6 +3,7 @@ package gorm
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
 	"errors"
 	"fmt"
 	"reflect"
@@ -12,6 +13,7 @@ import (
 	"gorm.io/gorm/clause"
 	"gorm.io/gorm/logger"
 	"gorm.io/gorm/schema"
+	"gorm.io/gorm/utils"
 )
 
 type result struct {
@@ -54,21 +56,40 @@ type CreateInterface[T any] interface {
 	Preload(association string, query func(db PreloadBuilder) error) ChainInterface[T]
 	Select(query string, args ...interface{}) CreateInterface[T]
 	Omit(columns ...string) CreateInterface[T]
+	Clauses(conds ...clause.Expression) CreateInterface[T]
+	Hints(hints ...clause.Hint) CreateInterface[T]
+	Unscoped() CreateInterface[T]
+	Debug() CreateInterface[T]
+	Attrs(attrs ...interface{}) ChainInterface[T]
+	Assign(attrs ...interface{}) ChainInterface[T]
 	MapColumns(m map[string]string) ChainInterface[T]
 	Distinct(args ...interface{}) ChainInterface[T]
 	Group(name string) ChainInterface[T]
 	Having(query interface{}, args ...interface{}) ChainInterface[T]
 	Order(value interface{}) ChainInterface[T]
+	With(name string, subquery interface{}, args ...interface{}) ChainInterface[T]
+	WithRecursive(name string, subquery interface{}, args ...interface{}) ChainInterface[T]
+	Union(query interface{}, args ...interface{}) ChainInterface[T]
+	UnionAll(query interface{}, args ...interface{}) ChainInterface[T]
+	Intersect(query interface{}, args ...interface{}) ChainInterface[T]
+	Except(query interface{}, args ...interface{}) ChainInterface[T]
 	Build(builder clause.Builder)
 
 	Delete(ctx context.Context) (rowsAffected int, err error)
 	Update(ctx context.Context, name string, value any) (rowsAffected int, err error)
 	Updates(ctx context.Context, t T) (rowsAffected int, err error)
+	UpdatesInBatches(ctx context.Context, r *[]T, batchSize int) (rowsAffected int, err error)
 	Count(ctx context.Context, column string) (result int64, err error)
+	Exists(ctx context.Context) (bool, error)
+	FirstOrInit(ctx context.Context) (T, error)
+	FirstOrCreate(ctx context.Context) (T, error)
 
 	Table(name string, args ...interface{}) CreateInterface[T]
 	Create(ctx context.Context, r *T) error
+	Save(ctx context.Context, r *T) error
 	CreateInBatches(ctx context.Context, r *[]T, batchSize int) error
+	CreateFrom(ctx context.Context, subquery *DB) (rowsAffected int, err error)
+	Merge(ctx context.Context, merge clause.Merge) (rowsAffected int, err error)
 	Set(assignments ...clause.Assigner) SetCreateOrUpdateInterface[T]
 }
 
@@ -89,6 +110,18 @@ type ChainInterface[T any] interface {
 	Group(name string) ChainInterface[T]
 	Having(query interface{}, args ...interface{}) ChainInterface[T]
 	Order(value interface{}) ChainInterface[T]
+	With(name string, subquery interface{}, args ...interface{}) ChainInterface[T]
+	WithRecursive(name string, subquery interface{}, args ...interface{}) ChainInterface[T]
+	Union(query interface{}, args ...interface{}) ChainInterface[T]
+	UnionAll(query interface{}, args ...interface{}) ChainInterface[T]
+	Intersect(query interface{}, args ...interface{}) ChainInterface[T]
+	Except(query interface{}, args ...interface{}) ChainInterface[T]
+	Clauses(conds ...clause.Expression) ChainInterface[T]
+	Hints(hints ...clause.Hint) ChainInterface[T]
+	Unscoped() ChainInterface[T]
+	Debug() ChainInterface[T]
+	Attrs(attrs ...interface{}) ChainInterface[T]
+	Assign(attrs ...interface{}) ChainInterface[T]
 	Set(assignments ...clause.Assigner) SetUpdateOnlyInterface[T]
 
 	Build(builder clause.Builder)
@@ -97,7 +130,12 @@ type ChainInterface[T any] interface {
 	Delete(ctx context.Context) (rowsAffected int, err error)
 	Update(ctx context.Context, name string, value any) (rowsAffected int, err error)
 	Updates(ctx context.Context, t T) (rowsAffected int, err error)
+	UpdatesInBatches(ctx context.Context, r *[]T, batchSize int) (rowsAffected int, err error)
+	Merge(ctx context.Context, merge clause.Merge) (rowsAffected int, err error)
 	Count(ctx context.Context, column string) (result int64, err error)
+	Exists(ctx context.Context) (bool, error)
+	FirstOrInit(ctx context.Context) (T, error)
+	FirstOrCreate(ctx context.Context) (T, error)
 }
 
 // SetUpdateOnlyInterface is returned by Set after chaining; only Update is allowed
@@ -118,6 +156,9 @@ type ExecInterface[T any] interface {
 	Take(context.Context) (T, error)
 	Find(ctx context.Context) ([]T, error)
 	FindInBatches(ctx context.Context, batchSize int, fc func(data []T, batch int) error) error
+	FindInBatchesByKeyset(ctx context.Context, batchSize int, columns []clause.OrderByColumn, fc func(data []T, batch int) error) error
+	Paginate(ctx context.Context, pagination *Pagination) ([]T, error)
+	Each(ctx context.Context) func(yield func(T, error) bool)
 	Row(ctx context.Context) *sql.Row
 	Rows(ctx context.Context) (*sql.Rows, error)
 }
@@ -166,8 +207,9 @@ func G[T any](db *DB, opts ...clause.Expression) Interface[T] {
 
 type g[T any] struct {
 	*createG[T]
-	db  *DB
-	ops []op
+	db      *DB
+	ops     []op
+	project op // selects the columns of the projected type after the ops
 }
 
 func (g *g[T]) apply(ctx context.Context) *DB {
@@ -179,6 +221,10 @@ func (g *g[T]) apply(ctx context.Context) *DB {
 	for _, op := range g.ops {
 		db = op(db)
 	}
+
+	if g.project != nil {
+		db = g.project(db)
+	}
 	return db
 }
 
@@ -219,6 +265,30 @@ func (c createG[T]) Omit(columns ...string) CreateInterface[T] {
 	})}
 }
 
+func (c createG[T]) Clauses(conds ...clause.Expression) CreateInterface[T] {
+	return createG[T]{c.with(func(db *DB) *DB {
+		return db.Clauses(conds...)
+	})}
+}
+
+func (c createG[T]) Hints(hints ...clause.Hint) CreateInterface[T] {
+	return createG[T]{c.with(func(db *DB) *DB {
+		return db.Hints(hints...)
+	})}
+}
+
+func (c createG[T]) Unscoped() CreateInterface[T] {
+	return createG[T]{c.with(func(db *DB) *DB {
+		return db.Unscoped()
+	})}
+}
+
+func (c createG[T]) Debug() CreateInterface[T] {
+	return createG[T]{c.with(func(db *DB) *DB {
+		return db.Debug()
+	})}
+}
+
 func (c createG[T]) Set(assignments ...clause.Assigner) SetCreateOrUpdateInterface[T] {
 	return c.processSet(assignments...)
 }
@@ -227,24 +297,34 @@ func (c createG[T]) Create(ctx context.Context, r *T) error {
 	return c.g.apply(ctx).Create(r).Error
 }
 
+func (c createG[T]) Save(ctx context.Context, r *T) error {
+	return c.g.apply(ctx).Save(r).Error
+}
+
 func (c createG[T]) CreateInBatches(ctx context.Context, r *[]T, batchSize int) error {
 	return c.g.apply(ctx).CreateInBatches(r, batchSize).Error
 }
 
+func (c createG[T]) CreateFrom(ctx context.Context, subquery *DB) (rowsAffected int, err error) {
+	r := new(T)
+	res := c.g.apply(ctx).Model(r).CreateFrom(subquery)
+	return int(res.RowsAffected), res.Error
+}
+
 type chainG[T any] struct {
 	execG[T]
 }
 
 func (c chainG[T]) getInstance() *DB {
-	var r T
-	return c.g.apply(context.Background()).Model(r).getInstance()
+	return c.instance(context.Background()).getInstance()
 }
 
 func (c chainG[T]) with(v op) chainG[T] {
 	return chainG[T]{
 		execG: execG[T]{g: &g[T]{
-			db:  c.g.db,
-			ops: append(append([]op(nil), c.g.ops...), v),
+			db:      c.g.db,
+			ops:     append(append([]op(nil), c.g.ops...), v),
+			project: c.g.project,
 		}},
 	}
 }
@@ -375,6 +455,11 @@ func (q *preloadBuilder) LimitPerRecord(num int) PreloadBuilder {
 
 func (c chainG[T]) Joins(jt clause.JoinTarget, on func(db JoinBuilder, joinTable clause.Table, curTable clause.Table) error) ChainInterface[T] {
 	return c.with(func(db *DB) *DB {
+		if jt.Association == "" && jt.Subquery != nil && jt.Table == "" {
+			db.AddError(fmt.Errorf("%w: alias is required when joining subquery", ErrInvalidData))
+			return db
+		}
+
 		if jt.Table == "" {
 			jt.Table = clause.JoinTable(strings.Split(jt.Association, ".")...).Name
 		}
@@ -398,7 +483,22 @@ func (c chainG[T]) Joins(jt clause.JoinTarget, on func(db JoinBuilder, joinTable
 			j.On = &where
 		}
 
-		if jt.Subquery != nil {
+		if jt.Association == "" && jt.Subquery != nil {
+			// derived table, selected columns are scanned into the struct field named as the alias
+			if db, ok := jt.Subquery.(interface{ getInstance() *DB }); ok && len(j.Selects) == 0 && len(j.Omits) == 0 {
+				stmt := db.getInstance().Statement
+				j.Selects, j.Omits = stmt.Selects, stmt.Omits
+			}
+
+			joinClause := clause.Join{Type: j.JoinType, Table: clause.Table{Name: j.Alias}, Subquery: jt.Subquery, Lateral: jt.Lateral}
+			if joinClause.Type == "" {
+				joinClause.Type = clause.LeftJoin
+			}
+			if j.On != nil {
+				joinClause.ON = *j.On
+			}
+			j.Expression = joinClause
+		} else if jt.Subquery != nil {
 			joinType := j.JoinType
 			if joinType == "" {
 				joinType = clause.LeftJoin
@@ -450,6 +550,42 @@ func (c chainG[T]) MapColumns(m map[string]string) ChainInterface[T] {
 	})
 }
 
+func (c chainG[T]) Clauses(conds ...clause.Expression) ChainInterface[T] {
+	return c.with(func(db *DB) *DB {
+		return db.Clauses(conds...)
+	})
+}
+
+func (c chainG[T]) Hints(hints ...clause.Hint) ChainInterface[T] {
+	return c.with(func(db *DB) *DB {
+		return db.Hints(hints...)
+	})
+}
+
+func (c chainG[T]) Unscoped() ChainInterface[T] {
+	return c.with(func(db *DB) *DB {
+		return db.Unscoped()
+	})
+}
+
+func (c chainG[T]) Debug() ChainInterface[T] {
+	return c.with(func(db *DB) *DB {
+		return db.Debug()
+	})
+}
+
+func (c chainG[T]) Attrs(attrs ...interface{}) ChainInterface[T] {
+	return c.with(func(db *DB) *DB {
+		return db.Attrs(attrs...)
+	})
+}
+
+func (c chainG[T]) Assign(attrs ...interface{}) ChainInterface[T] {
+	return c.with(func(db *DB) *DB {
+		return db.Assign(attrs...)
+	})
+}
+
 func (c chainG[T]) Set(assignments ...clause.Assigner) SetUpdateOnlyInterface[T] {
 	return c.processSet(assignments...)
 }
@@ -478,6 +614,42 @@ func (c chainG[T]) Order(value interface{}) ChainInterface[T] {
 	})
 }
 
+func (c chainG[T]) With(name string, subquery interface{}, args ...interface{}) ChainInterface[T] {
+	return c.with(func(db *DB) *DB {
+		return db.With(name, subquery, args...)
+	})
+}
+
+func (c chainG[T]) WithRecursive(name string, subquery interface{}, args ...interface{}) ChainInterface[T] {
+	return c.with(func(db *DB) *DB {
+		return db.WithRecursive(name, subquery, args...)
+	})
+}
+
+func (c chainG[T]) Union(query interface{}, args ...interface{}) ChainInterface[T] {
+	return c.with(func(db *DB) *DB {
+		return db.Union(query, args...)
+	})
+}
+
+func (c chainG[T]) UnionAll(query interface{}, args ...interface{}) ChainInterface[T] {
+	return c.with(func(db *DB) *DB {
+		return db.UnionAll(query, args...)
+	})
+}
+
+func (c chainG[T]) Intersect(query interface{}, args ...interface{}) ChainInterface[T] {
+	return c.with(func(db *DB) *DB {
+		return db.Intersect(query, args...)
+	})
+}
+
+func (c chainG[T]) Except(query interface{}, args ...interface{}) ChainInterface[T] {
+	return c.with(func(db *DB) *DB {
+		return db.Except(query, args...)
+	})
+}
+
 func (c chainG[T]) Preload(association string, query func(db PreloadBuilder) error) ChainInterface[T] {
 	return c.with(func(db *DB) *DB {
 		return db.Preload(association, func(tx *DB) *DB {
@@ -561,15 +733,31 @@ func (c chainG[T]) Preload(association string, query func(db PreloadBuilder) err
 	})
 }
 
+func (c chainG[T]) FirstOrInit(ctx context.Context) (T, error) {
+	var r T
+	err := c.g.apply(ctx).FirstOrInit(&r).Error
+	return r, err
+}
+
+func (c chainG[T]) FirstOrCreate(ctx context.Context) (T, error) {
+	var r T
+	err := c.g.apply(ctx).FirstOrCreate(&r).Error
+	return r, err
+}
+
 func (c chainG[T]) Delete(ctx context.Context) (rowsAffected int, err error) {
 	r := new(T)
 	res := c.g.apply(ctx).Delete(r)
 	return int(res.RowsAffected), res.Error
 }
 
+func (c chainG[T]) Merge(ctx context.Context, merge clause.Merge) (rowsAffected int, err error) {
+	res := c.instance(ctx).Merge(merge)
+	return int(res.RowsAffected), res.Error
+}
+
 func (c chainG[T]) Update(ctx context.Context, name string, value any) (rowsAffected int, err error) {
-	var r T
-	res := c.g.apply(ctx).Model(r).Update(name, value)
+	res := c.instance(ctx).Update(name, value)
 	return int(res.RowsAffected), res.Error
 }
 
@@ -578,16 +766,38 @@ func (c chainG[T]) Updates(ctx context.Context, t T) (rowsAffected int, err erro
 	return int(res.RowsAffected), res.Error
 }
 
+func (c chainG[T]) UpdatesInBatches(ctx context.Context, r *[]T, batchSize int) (rowsAffected int, err error) {
+	res := c.g.apply(ctx).UpdatesInBatches(r, batchSize)
+	return int(res.RowsAffected), res.Error
+}
+
 func (c chainG[T]) Count(ctx context.Context, column string) (result int64, err error) {
-	var r T
-	err = c.g.apply(ctx).Model(r).Select(column).Count(&result).Error
+	err = c.instance(ctx).Select(column).Count(&result).Error
 	return
 }
 
+func (c chainG[T]) Exists(ctx context.Context) (bool, error) {
+	var found int
+	tx := c.instance(ctx).Select("1").Limit(1)
+	row := tx.Row()
+	if tx.Error != nil || row == nil {
+		return false, tx.Error
+	}
+
+	if err := row.Scan(&found); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
 func (c chainG[T]) Build(builder clause.Builder) {
 	subdb := c.getInstance()
 	subdb.Logger = logger.Discard
 	subdb.DryRun = true
+	subdb.Statement.subquery = true
 
 	if stmt, ok := builder.(*Statement); ok {
 		if subdb.Statement.SQL.Len() > 0 {
@@ -632,8 +842,7 @@ func (g execG[T]) First(ctx context.Context) (T, error) {
 }
 
 func (g execG[T]) Scan(ctx context.Context, result interface{}) error {
-	var r T
-	err := g.g.apply(ctx).Model(r).Find(result).Error
+	err := g.instance(ctx).Find(result).Error
 	return err
 }
 
@@ -662,14 +871,538 @@ func (g execG[T]) FindInBatches(ctx context.Context, batchSize int, fc func(data
 	}).Error
 }
 
+func (g execG[T]) FindInBatchesByKeyset(ctx context.Context, batchSize int, columns []clause.OrderByColumn, fc func(data []T, batch int) error) error {
+	var data []T
+	return g.g.apply(ctx).FindInBatchesByKeyset(&data, batchSize, columns, func(tx *DB, batch int) error {
+		return fc(data, batch)
+	}).Error
+}
+
+func (g execG[T]) Paginate(ctx context.Context, pagination *Pagination) ([]T, error) {
+	var r []T
+	err := g.g.apply(ctx).Paginate(&r, pagination).Error
+	return r, err
+}
+
+// Each returns an iterator that streams the records one by one, compatible with iter.Seq2[T, error], e.g:
+//
+//	for user, err := range gorm.G[User](db).Where("active = ?", true).Each(ctx) {
+//		...
+//	}
+func (g execG[T]) Each(ctx context.Context) func(yield func(T, error) bool) {
+	return func(yield func(T, error) bool) {
+		g.instance(ctx).eachRow(func() interface{} {
+			return new(T)
+		}, func(dest interface{}, _ int, err error) bool {
+			// each row is scanned into a new value, the yielded values don't share slices or maps
+			var r T
+			if v, ok := dest.(*T); ok {
+				r = *v
+			}
+			return yield(r, err)
+		})
+	}
+}
+
 func (g execG[T]) Row(ctx context.Context) *sql.Row {
-	var r T
-	return g.g.apply(ctx).Model(r).Row()
+	return g.instance(ctx).Row()
 }
 
 func (g execG[T]) Rows(ctx context.Context) (*sql.Rows, error) {
+	return g.instance(ctx).Rows()
+}
+
+// instance returns the db with the model of T, keeps the model if it is already set, e.g: projected from another chain
+func (g execG[T]) instance(ctx context.Context) *DB {
+	db := g.g.apply(ctx)
+	if db.Statement.Model == nil {
+		var r T
+		db = db.Model(r)
+	}
+	return db
+}
+
+func (g execG[T]) scope() (*DB, []op) {
+	if g.g.project != nil {
+		return g.g.db, append(append([]op(nil), g.g.ops...), g.g.project)
+	}
+	return g.g.db, g.g.ops
+}
+
+// Project projects the chain into the result type U, the columns are selected by U's fields, matched with the fields
+// of T by name or column, or with the fields of the joined relations by the prefix of the relation name, like
+// `CompanyName` for the `name` of the joined `Company`, the table, scopes and soft delete conditions of T are kept,
+// the projected chain could be chained further, e.g:
+//
+//	type UserDTO struct {
+//		ID          uint
+//		Name        string
+//		Years       uint `gorm:"column:age"`
+//		CompanyName string
+//	}
+//
+//	dtos, err := gorm.Project[UserDTO](gorm.G[User](db).Joins(clause.LeftJoin.Association("Company"), nil)).Where("age > ?", 18).Find(ctx)
+func Project[U any, T any](chain ChainInterface[T]) ChainInterface[U] {
+	c, ok := chain.(interface{ scope() (*DB, []op) })
+	if !ok {
+		return errorChain[U]{err: fmt.Errorf("%w: project of %T", ErrNotImplemented, chain)}
+	}
+
+	db, ops := c.scope()
+	return chainG[U]{execG: execG[U]{g: &g[U]{
+		db:  db,
+		ops: append([]op(nil), ops...),
+		project: func(db *DB) *DB {
+			var (
+				r T
+				u U
+			)
+
+			// the model of the projected chain is kept for nested projections
+			tx := db
+			if tx.Statement.Model == nil {
+				tx = tx.Model(r)
+			}
+
+			if len(tx.Statement.Selects) > 0 || tx.Statement.Clauses["SELECT"].Expression != nil {
+				return tx
+			}
+
+			if err := tx.Statement.Parse(tx.Statement.Model); err != nil {
+				tx.AddError(err)
+				return tx
+			}
+
+			projectSchema, err := schema.Parse(&u, tx.cacheStore, tx.NamingStrategy)
+			if err != nil {
+				tx.AddError(err)
+				return tx
+			}
+
+			columns := make([]clause.Column, 0, len(projectSchema.DBNames))
+			for _, field := range projectSchema.Fields {
+				if field.DBName == "" {
+					continue
+				}
+
+				if column, ok := projectionColumn(tx.Statement, field); ok {
+					if column.Name != field.DBName || column.Table != clause.CurrentTable {
+						column.Alias = field.DBName
+					}
+					columns = append(columns, column)
+				}
+			}
+
+			tx.Statement.AddClause(clause.Select{Distinct: tx.Statement.Distinct, Columns: columns})
+			return tx
+		},
+	}}}
+}
+
+// errorChain is the ChainInterface returns the error for all calls
+type errorChain[T any] struct {
+	err error
+}
+
+func (e errorChain[T]) Scopes(scopes ...func(db *Statement)) ChainInterface[T] {
+	return e
+}
+
+func (e errorChain[T]) Where(query interface{}, args ...interface{}) ChainInterface[T] {
+	return e
+}
+
+func (e errorChain[T]) Not(query interface{}, args ...interface{}) ChainInterface[T] {
+	return e
+}
+
+func (e errorChain[T]) Or(query interface{}, args ...interface{}) ChainInterface[T] {
+	return e
+}
+
+func (e errorChain[T]) Limit(offset int) ChainInterface[T] {
+	return e
+}
+
+func (e errorChain[T]) Offset(offset int) ChainInterface[T] {
+	return e
+}
+
+func (e errorChain[T]) Joins(query clause.JoinTarget, on func(db JoinBuilder, joinTable clause.Table, curTable clause.Table) error) ChainInterface[T] {
+	return e
+}
+
+func (e errorChain[T]) Preload(association string, query func(db PreloadBuilder) error) ChainInterface[T] {
+	return e
+}
+
+func (e errorChain[T]) Select(query string, args ...interface{}) ChainInterface[T] {
+	return e
+}
+
+func (e errorChain[T]) Omit(columns ...string) ChainInterface[T] {
+	return e
+}
+
+func (e errorChain[T]) MapColumns(m map[string]string) ChainInterface[T] {
+	return e
+}
+
+func (e errorChain[T]) Distinct(args ...interface{}) ChainInterface[T] {
+	return e
+}
+
+func (e errorChain[T]) Group(name string) ChainInterface[T] {
+	return e
+}
+
+func (e errorChain[T]) Having(query interface{}, args ...interface{}) ChainInterface[T] {
+	return e
+}
+
+func (e errorChain[T]) Order(value interface{}) ChainInterface[T] {
+	return e
+}
+
+func (e errorChain[T]) With(name string, subquery interface{}, args ...interface{}) ChainInterface[T] {
+	return e
+}
+
+func (e errorChain[T]) WithRecursive(name string, subquery interface{}, args ...interface{}) ChainInterface[T] {
+	return e
+}
+
+func (e errorChain[T]) Union(query interface{}, args ...interface{}) ChainInterface[T] {
+	return e
+}
+
+func (e errorChain[T]) UnionAll(query interface{}, args ...interface{}) ChainInterface[T] {
+	return e
+}
+
+func (e errorChain[T]) Intersect(query interface{}, args ...interface{}) ChainInterface[T] {
+	return e
+}
+
+func (e errorChain[T]) Except(query interface{}, args ...interface{}) ChainInterface[T] {
+	return e
+}
+
+func (e errorChain[T]) Clauses(conds ...clause.Expression) ChainInterface[T] {
+	return e
+}
+
+func (e errorChain[T]) Hints(hints ...clause.Hint) ChainInterface[T] {
+	return e
+}
+
+func (e errorChain[T]) Unscoped() ChainInterface[T] {
+	return e
+}
+
+func (e errorChain[T]) Debug() ChainInterface[T] {
+	return e
+}
+
+func (e errorChain[T]) Attrs(attrs ...interface{}) ChainInterface[T] {
+	return e
+}
+
+func (e errorChain[T]) Assign(attrs ...interface{}) ChainInterface[T] {
+	return e
+}
+
+func (e errorChain[T]) Table(name string, args ...interface{}) ChainInterface[T] {
+	return e
+}
+
+func (e errorChain[T]) Set(assignments ...clause.Assigner) SetUpdateOnlyInterface[T] {
+	return errorSet[T](e)
+}
+
+func (e errorChain[T]) Build(builder clause.Builder) {
+	if stmt, ok := builder.(*Statement); ok {
+		stmt.AddError(e.err)
+	}
+}
+
+func (e errorChain[T]) Delete(ctx context.Context) (rowsAffected int, err error) {
+	return 0, e.err
+}
+
+func (e errorChain[T]) Update(ctx context.Context, name string, value any) (rowsAffected int, err error) {
+	return 0, e.err
+}
+
+func (e errorChain[T]) Updates(ctx context.Context, t T) (rowsAffected int, err error) {
+	return 0, e.err
+}
+
+func (e errorChain[T]) UpdatesInBatches(ctx context.Context, r *[]T, batchSize int) (rowsAffected int, err error) {
+	return 0, e.err
+}
+
+func (e errorChain[T]) Merge(ctx context.Context, merge clause.Merge) (rowsAffected int, err error) {
+	return 0, e.err
+}
+
+func (e errorChain[T]) Count(ctx context.Context, column string) (result int64, err error) {
+	return 0, e.err
+}
+
+func (e errorChain[T]) Exists(ctx context.Context) (bool, error) {
+	return false, e.err
+}
+
+func (e errorChain[T]) FirstOrInit(ctx context.Context) (T, error) {
+	var r T
+	return r, e.err
+}
+
+func (e errorChain[T]) FirstOrCreate(ctx context.Context) (T, error) {
+	var r T
+	return r, e.err
+}
+
+func (e errorChain[T]) Scan(ctx context.Context, r interface{}) error {
+	return e.err
+}
+
+func (e errorChain[T]) First(ctx context.Context) (T, error) {
 	var r T
-	return g.g.apply(ctx).Model(r).Rows()
+	return r, e.err
+}
+
+func (e errorChain[T]) Last(ctx context.Context) (T, error) {
+	var r T
+	return r, e.err
+}
+
+func (e errorChain[T]) Take(ctx context.Context) (T, error) {
+	var r T
+	return r, e.err
+}
+
+func (e errorChain[T]) Find(ctx context.Context) ([]T, error) {
+	return nil, e.err
+}
+
+func (e errorChain[T]) FindInBatches(ctx context.Context, batchSize int, fc func(data []T, batch int) error) error {
+	return e.err
+}
+
+func (e errorChain[T]) FindInBatchesByKeyset(ctx context.Context, batchSize int, columns []clause.OrderByColumn, fc func(data []T, batch int) error) error {
+	return e.err
+}
+
+func (e errorChain[T]) Paginate(ctx context.Context, pagination *Pagination) ([]T, error) {
+	return nil, e.err
+}
+
+func (e errorChain[T]) Each(ctx context.Context) func(yield func(T, error) bool) {
+	return func(yield func(T, error) bool) {
+		var r T
+		yield(r, e.err)
+	}
+}
+
+// Row returns the row carrying the error, which is returned by its Scan and Err
+func (e errorChain[T]) Row(ctx context.Context) *sql.Row {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	// the connector fails to connect with the error, the row of the query keeps the error
+	db := sql.OpenDB(errorConnector{err: e.err})
+	defer db.Close()
+	return db.QueryRowContext(ctx, "")
+}
+
+func (e errorChain[T]) Rows(ctx context.Context) (*sql.Rows, error) {
+	return nil, e.err
+}
+
+// errorSet is the SetUpdateOnlyInterface returns the error of the chain
+type errorSet[T any] errorChain[T]
+
+func (e errorSet[T]) Update(ctx context.Context) (rowsAffected int, err error) {
+	return 0, e.err
+}
+
+// errorConnector is the driver.Connector fails to connect with the error
+type errorConnector struct {
+	err error
+}
+
+func (c errorConnector) Connect(context.Context) (driver.Conn, error) {
+	return nil, c.err
+}
+
+func (c errorConnector) Driver() driver.Driver {
+	return nil
+}
+
+// projectionColumn returns the column of the projected field
+func projectionColumn(stmt *Statement, field *schema.Field) (clause.Column, bool) {
+	if f := stmt.Schema.LookUpField(field.Name); f != nil && f.DBName != "" {
+		return clause.Column{Table: clause.CurrentTable, Name: f.DBName}, true
+	} else if f := stmt.Schema.LookUpField(field.DBName); f != nil && f.DBName != "" {
+		return clause.Column{Table: clause.CurrentTable, Name: f.DBName}, true
+	}
+
+	// fields prefixed with the joined relation name, like `CompanyName` or `ManagerCompanyName`
+	for _, join := range stmt.Joins {
+		prefix := strings.ReplaceAll(join.Name, ".", "")
+		if len(field.Name) <= len(prefix) || !strings.HasPrefix(field.Name, prefix) {
+			continue
+		}
+
+		if rel, alias := projectionRelation(stmt, join.Name); rel != nil && alias != "" {
+			if f := rel.FieldSchema.LookUpField(field.Name[len(prefix):]); f != nil && f.DBName != "" {
+				return clause.Column{Table: alias, Name: f.DBName}, true
+			}
+		}
+	}
+	return clause.Column{}, false
+}
+
+// projectionRelation returns the relation of the path like `Manager.Company` and its table alias, the alias is blank
+// if the relation is not joined
+func projectionRelation(stmt *Statement, path string) (*schema.Relationship, string) {
+	var (
+		rel   *schema.Relationship
+		names = strings.Split(path, ".")
+		s     = stmt.Schema
+	)
+
+	for _, name := range names {
+		if rel = s.Relationships.Relations[name]; rel == nil {
+			return nil, ""
+		}
+		s = rel.FieldSchema
+	}
+
+	for _, join := range stmt.Joins {
+		if join.Name == path {
+			if join.Alias != "" {
+				return rel, join.Alias
+			}
+			return rel, utils.JoinNestedRelationNames(names)
+		}
+	}
+	return rel, ""
+}
+
+// ScalarInterface queries the values of the column in type V, the column is the name of the field or column, or the
+// expression like gorm.Expr("age * 2")
+type ScalarInterface[V any] interface {
+	Sum(ctx context.Context, column interface{}) (V, error)
+	Avg(ctx context.Context, column interface{}) (V, error)
+	Min(ctx context.Context, column interface{}) (V, error)
+	Max(ctx context.Context, column interface{}) (V, error)
+	Pluck(ctx context.Context, column interface{}) ([]V, error)
+}
+
+// Scalar returns the queries of the column values in type V with the conditions of the chain, the aggregate functions
+// return zero value if no rows found, chains with Group are not supported as only one value could be returned, e.g:
+//
+//	total, err := gorm.Scalar[float64](gorm.G[Order](db).Where("user_id = ?", 1)).Sum(ctx, "amount")
+//	names, err := gorm.Scalar[string](gorm.G[User](db).Where("age > ?", 18)).Pluck(ctx, "name")
+func Scalar[V any, T any](chain ExecInterface[T]) ScalarInterface[V] {
+	c, ok := chain.(interface{ instance(context.Context) *DB })
+	if !ok {
+		return scalarG[V]{err: fmt.Errorf("%w: scalar of %T", ErrNotImplemented, chain)}
+	}
+	return scalarG[V]{instance: c.instance}
+}
+
+type scalarG[V any] struct {
+	instance func(context.Context) *DB
+	err      error
+}
+
+func (s scalarG[V]) Sum(ctx context.Context, column interface{}) (V, error) {
+	return s.aggregate(ctx, "SUM", column)
+}
+
+func (s scalarG[V]) Avg(ctx context.Context, column interface{}) (V, error) {
+	return s.aggregate(ctx, "AVG", column)
+}
+
+func (s scalarG[V]) Min(ctx context.Context, column interface{}) (V, error) {
+	return s.aggregate(ctx, "MIN", column)
+}
+
+func (s scalarG[V]) Max(ctx context.Context, column interface{}) (V, error) {
+	return s.aggregate(ctx, "MAX", column)
+}
+
+func (s scalarG[V]) Pluck(ctx context.Context, column interface{}) (result []V, err error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	tx := s.instance(ctx)
+	expr, err := scalarColumn(tx.Statement, column)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Select("?", expr).Scan(&result).Error
+	return
+}
+
+// aggregate selects the aggregate function of the column, returns zero value if the result is NULL, e.g: no rows found
+func (s scalarG[V]) aggregate(ctx context.Context, function string, column interface{}) (result V, err error) {
+	if s.err != nil {
+		return result, s.err
+	}
+
+	tx := s.instance(ctx)
+	if _, ok := tx.Statement.Clauses["GROUP BY"]; ok {
+		// only one row could be returned, the results of the groups should be scanned with Select and Scan
+		return result, fmt.Errorf("%w: %s with group by", ErrInvalidData, function)
+	}
+
+	expr, err := scalarColumn(tx.Statement, column)
+	if err != nil {
+		return result, err
+	}
+
+	rows, err := tx.Select(function+"(?)", expr).Rows()
+	if err != nil {
+		return result, err
+	}
+	defer rows.Close()
+
+	var value *V
+	if rows.Next() {
+		if err = rows.Scan(&value); err == nil && value != nil {
+			result = *value
+		}
+	}
+
+	if err == nil {
+		err = rows.Err()
+	}
+	return result, err
+}
+
+// scalarColumn returns the expression of the column, the names of fields are converted to the qualified columns
+func scalarColumn(stmt *Statement, column interface{}) (interface{}, error) {
+	switch v := column.(type) {
+	case string:
+		if stmt.Parse(stmt.Model) == nil {
+			if field := stmt.Schema.LookUpField(v); field != nil && field.DBName != "" {
+				return clause.Column{Table: clause.CurrentTable, Name: field.DBName}, nil
+			}
+		}
+		return clause.Column{Name: v}, nil
+	case clause.Expression:
+		return v, nil
+	default:
+		return nil, fmt.Errorf("%w: unsupported column %v", ErrInvalidData, column)
+	}
 }
 
 func (c chainG[T]) processSet(items ...clause.Assigner) setCreateOrUpdateG[T] {
@@ -64,6 +64,13 @@ type Config struct {
 	TranslateError bool
 	// PropagateUnscoped propagate Unscoped to every other nested statement
 	PropagateUnscoped bool
+	// CommentTags extract tags from the statement context by the tag name, the tags are appended to the statements in
+	// sqlcommenter format like `/*request_id='1',route='%2Fusers'*/`, blank values are skipped, the tags are not
+	// supported in PrepareStmt mode and are dropped with a warning logged once, as it would prepare a statement for every request
+	CommentTags map[string]func(ctx context.Context) string
+	// StrictTags reports unknown tag keys, conflicting settings and malformed relationship tags as errors when parsing
+	// the models, register the tag keys of plugins with schema.RegisterTagKeys
+	StrictTags bool
 
 	// ClauseBuilders clause builder
 	ClauseBuilders map[string]clause.ClauseBuilder
@@ -199,6 +206,10 @@ func Open(dialector Dialector, opts ...Option) (db *DB, err error) {
 		config.cacheStore = &sync.Map{}
 	}
 
+	if config.StrictTags {
+		schema.EnableStrictTags(config.cacheStore)
+	}
+
 	db = &DB{Config: config, clone: 1}
 
 	db.callbacks = initializeCallbacks(db)
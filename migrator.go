@@ -47,6 +47,34 @@ type ColumnType interface {
 	DefaultValue() (value string, ok bool)
 }
 
+// GeneratedColumnType column type reports the generation of generated columns, the generated columns are recreated
+// by the migrator if the generation changed, the generation isn't migrated if the column type doesn't report it.
+// The generated columns are created with `GENERATED ALWAYS AS (expr) STORED`, the dialects with different syntax
+// override FullDataTypeOf, like the computed columns of SQL Server
+type GeneratedColumnType interface {
+	GenerationExpression() (expression string, ok bool) // blank expression for normal columns
+	GeneratedStored() (stored bool, ok bool)
+}
+
+// EnumDialector dialector supports native enum types, the enum fields are migrated with the native types instead of
+// check constraints
+type EnumDialector interface {
+	// EnumDataTypeOf returns the native enum type of the field, like `ENUM('draft','published')`, blank if unsupported
+	EnumDataTypeOf(field *schema.Field) string
+}
+
+// EnumTypeMigrator migrator creates the named enum types of the enum fields and adds the enum values to them before
+// migrating the tables, like `CREATE TYPE ... AS ENUM (...)` of Postgres
+type EnumTypeMigrator interface {
+	MigrateEnumType(value interface{}, field *schema.Field) error
+}
+
+// CheckConstraintMigrator migrator reads the definition of check constraints, the enum check constraints are recreated
+// when the enum values are added
+type CheckConstraintMigrator interface {
+	CheckConstraintDefinition(value interface{}, name string) (definition string, err error) // blank if not found
+}
+
 type Index interface {
 	Table() string
 	Name() string
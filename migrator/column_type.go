@@ -21,6 +21,9 @@ type ColumnType struct {
 	ScanTypeValue      reflect.Type
 	CommentValue       sql.NullString
 	DefaultValueValue  sql.NullString
+
+	GenerationExpressionValue sql.NullString
+	GeneratedStoredValue      sql.NullBool
 }
 
 // Name returns the name or alias of the column.
@@ -123,3 +126,13 @@ func (ct ColumnType) Comment() (value string, ok bool) {
 func (ct ColumnType) DefaultValue() (value string, ok bool) {
 	return ct.DefaultValueValue.String, ct.DefaultValueValue.Valid
 }
+
+// GenerationExpression returns the generation expression of current column, blank for normal columns.
+func (ct ColumnType) GenerationExpression() (expression string, ok bool) {
+	return ct.GenerationExpressionValue.String, ct.GenerationExpressionValue.Valid
+}
+
+// GeneratedStored returns current generated column is stored or virtual.
+func (ct ColumnType) GeneratedStored() (stored bool, ok bool) {
+	return ct.GeneratedStoredValue.Bool, ct.GeneratedStoredValue.Valid
+}
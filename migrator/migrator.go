@@ -27,6 +27,13 @@ import (
 // -"%$#@789"
 var regFullDataType = regexp.MustCompile(`\D*(\d+)\D?`)
 
+// regEnumNumber matches the words and numbers in the definition of check constraint to look up the numeric enum values,
+// like `1` and `-2` of `priority IN (1,-2)`
+var regEnumNumber = regexp.MustCompile(`-?[\w.]+`)
+
+// regGenerationIgnoredChars matches the characters ignored when comparing generation expressions
+var regGenerationIgnoredChars = regexp.MustCompile("[\\s()`\"\\[\\]]")
+
 // TODO:? Create const vars for raw sql queries ?
 
 var _ gorm.Migrator = (*Migrator)(nil)
@@ -84,18 +91,51 @@ func (m Migrator) DataTypeOf(field *schema.Field) string {
 		}
 	}
 
+	if dataType := m.enumDataTypeOf(field); dataType != "" {
+		return dataType
+	}
+
 	return m.Dialector.DataTypeOf(field)
 }
 
+// enumDataTypeOf returns the native enum type of the enum field, blank if the dialector doesn't support it
+func (m Migrator) enumDataTypeOf(field *schema.Field) string {
+	if len(field.EnumValues) > 0 && field.TagSettings["TYPE"] == "" {
+		if enumDialector, ok := m.DB.Dialector.(gorm.EnumDialector); ok {
+			return enumDialector.EnumDataTypeOf(field)
+		}
+	}
+	return ""
+}
+
+// migrateEnumTypes creates or alters the named enum types of the enum fields with the migrator of the dialector before
+// migrating the table, like `CREATE TYPE ... AS ENUM (...)` of Postgres
+func (m Migrator) migrateEnumTypes(tx *gorm.DB, value interface{}, stmt *gorm.Statement) error {
+	if enumTypeMigrator, ok := tx.Migrator().(gorm.EnumTypeMigrator); ok {
+		for _, dbName := range stmt.Schema.DBNames {
+			if field := stmt.Schema.FieldsByDBName[dbName]; !field.IgnoreMigration && m.enumDataTypeOf(field) != "" {
+				if err := enumTypeMigrator.MigrateEnumType(value, field); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
 // FullDataTypeOf returns field's db full data type
 func (m Migrator) FullDataTypeOf(field *schema.Field) (expr clause.Expr) {
 	expr.SQL = m.DataTypeOf(field)
 
+	if field.GeneratedExpression != "" {
+		expr.SQL += " GENERATED ALWAYS AS (" + field.GeneratedExpression + ") " + field.GeneratedStorage
+	}
+
 	if field.NotNull {
 		expr.SQL += " NOT NULL"
 	}
 
-	if field.HasDefaultValue && (field.DefaultValueInterface != nil || field.DefaultValue != "") {
+	if field.HasDefaultValue && field.GeneratedExpression == "" && (field.DefaultValueInterface != nil || field.DefaultValue != "") {
 		if field.DefaultValueInterface != nil {
 			defaultStmt := &gorm.Statement{Vars: []interface{}{field.DefaultValueInterface}}
 			m.Dialector.BindVarTo(defaultStmt, defaultStmt, field.DefaultValueInterface)
@@ -133,6 +173,10 @@ func (m Migrator) AutoMigrate(values ...interface{}) error {
 					return errors.New("failed to get schema")
 				}
 
+				if err := m.migrateEnumTypes(execTx, value, stmt); err != nil {
+					return err
+				}
+
 				columnTypes, err := queryTx.Migrator().ColumnTypes(value)
 				if err != nil {
 					return err
@@ -180,10 +224,29 @@ func (m Migrator) AutoMigrate(values ...interface{}) error {
 				}
 
 				for _, chk := range parseCheckConstraints {
+					if chk.Enum && m.enumDataTypeOf(chk.Field) != "" {
+						continue
+					}
+
 					if !queryTx.Migrator().HasConstraint(value, chk.Name) {
 						if err := execTx.Migrator().CreateConstraint(value, chk.Name); err != nil {
 							return err
 						}
+					} else if checker, ok := queryTx.Migrator().(gorm.CheckConstraintMigrator); ok && chk.Enum {
+						// recreate the check constraint if enum values added
+						definition, err := checker.CheckConstraintDefinition(value, chk.Name)
+						if err != nil {
+							return err
+						}
+
+						if definition != "" && !hasEnumValues(definition, chk.Field) {
+							if err := execTx.Migrator().DropConstraint(value, chk.Name); err != nil {
+								return err
+							}
+							if err := execTx.Migrator().CreateConstraint(value, chk.Name); err != nil {
+								return err
+							}
+						}
 					}
 				}
 
@@ -222,6 +285,10 @@ func (m Migrator) CreateTable(values ...interface{}) error {
 				return errors.New("failed to get schema")
 			}
 
+			if err := m.migrateEnumTypes(tx, value, stmt); err != nil {
+				return err
+			}
+
 			var (
 				createTableSQL          = "CREATE TABLE ? ("
 				values                  = []interface{}{m.CurrentTable(stmt)}
@@ -295,6 +362,10 @@ func (m Migrator) CreateTable(values ...interface{}) error {
 			}
 
 			for _, chk := range stmt.Schema.ParseCheckConstraints() {
+				if chk.Enum && m.enumDataTypeOf(chk.Field) != "" {
+					continue
+				}
+
 				createTableSQL += "CONSTRAINT ? CHECK (?),"
 				values = append(values, clause.Column{Name: chk.Name}, clause.Expr{SQL: chk.Constraint})
 			}
@@ -583,6 +654,29 @@ func (m Migrator) MigrateColumn(value interface{}, field *schema.Field, columnTy
 		}
 	}
 
+	// check values of native enum type
+	if enumDataType := m.enumDataTypeOf(field); enumDataType != "" {
+		if ct, ok := columnType.ColumnType(); ok && normalizeEnumDataType(ct) != normalizeEnumDataType(enumDataType) {
+			alterColumn = true
+		}
+	}
+
+	// check generated column, the generation is reported by the column type of the dialector
+	if generatedColumnType, ok := columnType.(gorm.GeneratedColumnType); ok {
+		if expression, ok := generatedColumnType.GenerationExpression(); ok {
+			stored, _ := generatedColumnType.GeneratedStored()
+			if field.GeneratedExpression == "" {
+				if expression != "" {
+					// generated column -> normal column, recreate the column and keep the generated values
+					return m.recreateGeneratedColumn(value, field, expression)
+				}
+			} else if expression == "" || normalizeGeneration(expression) != normalizeGeneration(field.GeneratedExpression) ||
+				stored != (field.GeneratedStorage == "STORED") {
+				return m.recreateGeneratedColumn(value, field, "")
+			}
+		}
+	}
+
 	if alterColumn {
 		if err := m.DB.Migrator().AlterColumn(value, field.DBName); err != nil {
 			return err
@@ -596,6 +690,58 @@ func (m Migrator) MigrateColumn(value interface{}, field *schema.Field, columnTy
 	return nil
 }
 
+// recreateGeneratedColumn recreates the column as the generation couldn't be altered in most databases, the values
+// are computed with the generation expression of the dropped column if given
+func (m Migrator) recreateGeneratedColumn(value interface{}, field *schema.Field, expression string) error {
+	if err := m.DB.Migrator().DropColumn(value, field.DBName); err != nil {
+		return err
+	}
+
+	if err := m.DB.Migrator().AddColumn(value, field.DBName); err != nil {
+		return err
+	}
+
+	if expression == "" {
+		return nil
+	}
+
+	return m.RunWithValue(value, func(stmt *gorm.Statement) error {
+		return m.DB.Exec("UPDATE ? SET ? = "+expression, m.CurrentTable(stmt), clause.Column{Name: field.DBName}).Error
+	})
+}
+
+// normalizeGeneration normalizes the generation expression for comparing, as the databases may reformat it with
+// quotes, parentheses and spaces, like `(`price` * `quantity`)`
+func normalizeGeneration(expression string) string {
+	return strings.ToLower(regGenerationIgnoredChars.ReplaceAllString(expression, ""))
+}
+
+func normalizeEnumDataType(dataType string) string {
+	return strings.ToLower(strings.Join(strings.Fields(dataType), ""))
+}
+
+// hasEnumValues checks all enum values of the field are in the definition of check constraint
+func hasEnumValues(definition string, field *schema.Field) bool {
+	var numbers map[string]bool
+	if field.GORMDataType != schema.String {
+		numbers = map[string]bool{}
+		for _, number := range regEnumNumber.FindAllString(definition, -1) {
+			numbers[number] = true
+		}
+	}
+
+	for _, value := range field.EnumValues {
+		if field.GORMDataType == schema.String {
+			if !strings.Contains(definition, "'"+strings.ReplaceAll(value, "'", "''")+"'") {
+				return false
+			}
+		} else if !numbers[value] {
+			return false
+		}
+	}
+	return true
+}
+
 func (m Migrator) MigrateColumnUnique(value interface{}, field *schema.Field, columnType gorm.ColumnType) error {
 	unique, ok := columnType.Unique()
 	if !ok || field.PrimaryKey {
@@ -0,0 +1,151 @@
+package gorm
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+)
+
+// Pagination keyset pagination, pages the rows ordered by Columns after the position of Cursor, or before it if
+// the cursor is the Prev cursor of a page. Next and Prev are set to the cursors of the next and previous pages
+// after paginating, they are blank if there are no more rows.
+//
+// The primary keys are appended to the columns if not included to make the position unique, the columns should
+// not be NULL as rows with NULL values can't be compared.
+type Pagination struct {
+	Cursor  string
+	Limit   int
+	Columns []clause.OrderByColumn
+	Next    string
+	Prev    string
+}
+
+// cursor the position of the row in keyset pagination, encoded as an opaque token, the columns are kept to reject
+// the cursors of other paginations
+type cursor struct {
+	Columns  []string          `json:"c"`
+	Values   []json.RawMessage `json:"v"`
+	Backward bool              `json:"b,omitempty"`
+}
+
+// paginationColumns returns the fields of the pagination columns, the primary keys are appended if not included
+func paginationColumns(s *schema.Schema, columns []clause.OrderByColumn) ([]*schema.Field, []clause.OrderByColumn, error) {
+	fields := make([]*schema.Field, 0, len(columns)+len(s.PrimaryFields))
+	orderColumns := make([]clause.OrderByColumn, 0, len(columns)+len(s.PrimaryFields))
+	included := map[string]bool{}
+
+	for _, column := range columns {
+		field := s.LookUpField(column.Column.Name)
+		if field == nil || field.DBName == "" {
+			return nil, nil, fmt.Errorf("%w: %s is not found in %s", ErrInvalidField, column.Column.Name, s.Name)
+		}
+
+		if !included[field.DBName] {
+			included[field.DBName] = true
+			fields = append(fields, field)
+			orderColumns = append(orderColumns, clause.OrderByColumn{Column: clause.Column{Table: clause.CurrentTable, Name: field.DBName}, Desc: column.Desc})
+		}
+	}
+
+	for _, field := range s.PrimaryFields {
+		if !included[field.DBName] {
+			included[field.DBName] = true
+			fields = append(fields, field)
+			orderColumns = append(orderColumns, clause.OrderByColumn{Column: clause.Column{Table: clause.CurrentTable, Name: field.DBName}})
+		}
+	}
+
+	if len(fields) == 0 {
+		return nil, nil, fmt.Errorf("%w: pagination columns required", ErrInvalidData)
+	}
+	return fields, orderColumns, nil
+}
+
+// cursorKeys returns the keys of the pagination columns identifying the cursors, like `age DESC` and `id`
+func cursorKeys(columns []clause.OrderByColumn) []string {
+	keys := make([]string, len(columns))
+	for idx, column := range columns {
+		keys[idx] = column.Column.Name
+		if column.Desc {
+			keys[idx] += " DESC"
+		}
+	}
+	return keys
+}
+
+// encodeCursor encodes the values of the row to cursor token
+func encodeCursor(stmt *Statement, keys []string, fields []*schema.Field, row reflect.Value, backward bool) (string, error) {
+	c := cursor{Columns: keys, Values: make([]json.RawMessage, len(fields)), Backward: backward}
+	for idx, field := range fields {
+		value, _ := field.ValueOf(stmt.Context, row)
+		data, err := json.Marshal(value)
+		if err != nil {
+			return "", err
+		}
+		c.Values[idx] = data
+	}
+
+	data, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// decodeCursor decodes the cursor token to the values of the fields, the cursor should be encoded with the same
+// columns, and its values should be the non-NULL values of the field types
+func decodeCursor(token string, keys []string, fields []*schema.Field) (values []interface{}, backward bool, err error) {
+	var c cursor
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err == nil {
+		err = json.Unmarshal(data, &c)
+	}
+
+	if err != nil || len(c.Values) != len(fields) || len(c.Columns) != len(keys) {
+		return nil, false, fmt.Errorf("%w: invalid cursor %v", ErrInvalidData, token)
+	}
+
+	for idx, key := range keys {
+		if c.Columns[idx] != key {
+			return nil, false, fmt.Errorf("%w: cursor of columns %v doesn't match the pagination columns %v", ErrInvalidData, c.Columns, keys)
+		}
+	}
+
+	values = make([]interface{}, len(fields))
+	for idx, field := range fields {
+		if string(c.Values[idx]) == "null" {
+			return nil, false, fmt.Errorf("%w: invalid cursor value of %s, NULL can't be compared", ErrInvalidData, field.Name)
+		}
+
+		value := reflect.New(field.FieldType)
+		if err := json.Unmarshal(c.Values[idx], value.Interface()); err != nil {
+			return nil, false, fmt.Errorf("%w: invalid cursor value of %s, got error %v", ErrInvalidData, field.Name, err)
+		}
+		values[idx] = value.Elem().Interface()
+	}
+	return values, c.Backward, nil
+}
+
+// keysetCondition returns the condition of rows after the position in the order of columns, the tuple comparison is
+// expanded with OR as the columns could be ordered in different directions, like `(a > 1) OR (a = 1 AND b < 2)`
+func keysetCondition(columns []clause.OrderByColumn, values []interface{}) clause.Expression {
+	conds := make([]clause.Expression, len(columns))
+	for idx, column := range columns {
+		exprs := make([]clause.Expression, 0, idx+1)
+		for i := 0; i < idx; i++ {
+			exprs = append(exprs, clause.Eq{Column: columns[i].Column, Value: values[i]})
+		}
+
+		if column.Desc {
+			exprs = append(exprs, clause.Lt{Column: column.Column, Value: values[idx]})
+		} else {
+			exprs = append(exprs, clause.Gt{Column: column.Column, Value: values[idx]})
+		}
+		conds[idx] = clause.And(exprs...)
+	}
+	return clause.Or(conds...)
+}
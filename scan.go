@@ -188,13 +188,7 @@ func Scan(rows Rows, db *DB, mode ScanMode) {
 			db.AddError(rows.Scan(dest))
 		}
 	default:
-		var (
-			fields       = make([]*schema.Field, len(columns))
-			joinFields   [][]*schema.Field
-			sch          = db.Statement.Schema
-			reflectValue = db.Statement.ReflectValue
-		)
-
+		reflectValue := db.Statement.ReflectValue
 		if reflectValue.Kind() == reflect.Interface {
 			reflectValue = reflectValue.Elem()
 		}
@@ -209,81 +203,7 @@ func Scan(rows Rows, db *DB, mode ScanMode) {
 			reflectValueType = reflectValueType.Elem()
 		}
 
-		if sch != nil {
-			if reflectValueType != sch.ModelType && reflectValueType.Kind() == reflect.Struct {
-				sch, _ = schema.Parse(db.Statement.Dest, db.cacheStore, db.NamingStrategy)
-			}
-
-			if len(columns) == 1 {
-				// Is Pluck
-				if _, ok := reflect.New(reflectValueType).Interface().(sql.Scanner); (reflectValueType != sch.ModelType && ok) || // is scanner
-					reflectValueType.Kind() != reflect.Struct || // is not struct
-					sch.ModelType.ConvertibleTo(schema.TimeReflectType) { // is time
-					sch = nil
-				}
-			}
-
-			// Not Pluck
-			if sch != nil {
-				matchedFieldCount := make(map[string]int, len(columns))
-				for idx, column := range columns {
-					if field := sch.LookUpField(column); field != nil && field.Readable {
-						fields[idx] = field
-						if count, ok := matchedFieldCount[column]; ok {
-							// handle duplicate fields
-							for _, selectField := range sch.Fields {
-								if selectField.DBName == column && selectField.Readable {
-									if count == 0 {
-										matchedFieldCount[column]++
-										fields[idx] = selectField
-										break
-									}
-									count--
-								}
-							}
-						} else {
-							matchedFieldCount[column] = 1
-						}
-					} else if names := utils.SplitNestedRelationName(column); len(names) > 1 { // has nested relation
-						aliasName := utils.JoinNestedRelationNames(names[0 : len(names)-1])
-						for _, join := range db.Statement.Joins {
-							if join.Alias == aliasName {
-								names = append(strings.Split(join.Name, "."), names[len(names)-1])
-								break
-							}
-						}
-
-						if rel, ok := sch.Relationships.Relations[names[0]]; ok {
-							subNameCount := len(names)
-							// nested relation fields
-							relFields := make([]*schema.Field, 0, subNameCount-1)
-							relFields = append(relFields, rel.Field)
-							for _, name := range names[1 : subNameCount-1] {
-								rel = rel.FieldSchema.Relationships.Relations[name]
-								relFields = append(relFields, rel.Field)
-							}
-							// latest name is raw dbname
-							dbName := names[subNameCount-1]
-							if field := rel.FieldSchema.LookUpField(dbName); field != nil && field.Readable {
-								fields[idx] = field
-
-								if len(joinFields) == 0 {
-									joinFields = make([][]*schema.Field, len(columns))
-								}
-								relFields = append(relFields, field)
-								joinFields[idx] = relFields
-								continue
-							}
-						}
-						var val interface{}
-						values[idx] = &val
-					} else {
-						var val interface{}
-						values[idx] = &val
-					}
-				}
-			}
-		}
+		fields, joinFields := db.scanFields(columns, values, reflectValueType)
 
 		switch reflectValue.Kind() {
 		case reflect.Slice, reflect.Array:
@@ -367,3 +287,149 @@ func Scan(rows Rows, db *DB, mode ScanMode) {
 		db.AddError(ErrRecordNotFound)
 	}
 }
+
+// scanFields returns the fields of the columns to scan into the struct of reflectValueType, the fields of the joined
+// relations are returned in joinFields, the values of the unmatched columns are initialized to be discarded
+func (db *DB) scanFields(columns []string, values []interface{}, reflectValueType reflect.Type) (fields []*schema.Field, joinFields [][]*schema.Field) {
+	fields = make([]*schema.Field, len(columns))
+	sch := db.Statement.Schema
+	if sch != nil {
+		if reflectValueType != sch.ModelType && reflectValueType.Kind() == reflect.Struct {
+			sch, _ = schema.Parse(db.Statement.Dest, db.cacheStore, db.NamingStrategy)
+		}
+
+		if len(columns) == 1 {
+			// Is Pluck
+			if _, ok := reflect.New(reflectValueType).Interface().(sql.Scanner); (reflectValueType != sch.ModelType && ok) || // is scanner
+				reflectValueType.Kind() != reflect.Struct || // is not struct
+				sch.ModelType.ConvertibleTo(schema.TimeReflectType) { // is time
+				sch = nil
+			}
+		}
+
+		// Not Pluck
+		if sch != nil {
+			matchedFieldCount := make(map[string]int, len(columns))
+			for idx, column := range columns {
+				if field := sch.LookUpField(column); field != nil && field.Readable {
+					fields[idx] = field
+					if count, ok := matchedFieldCount[column]; ok {
+						// handle duplicate fields
+						for _, selectField := range sch.Fields {
+							if selectField.DBName == column && selectField.Readable {
+								if count == 0 {
+									matchedFieldCount[column]++
+									fields[idx] = selectField
+									break
+								}
+								count--
+							}
+						}
+					} else {
+						matchedFieldCount[column] = 1
+					}
+				} else if names := utils.SplitNestedRelationName(column); len(names) > 1 { // has nested relation
+					aliasName := utils.JoinNestedRelationNames(names[0 : len(names)-1])
+					for _, join := range db.Statement.Joins {
+						if join.Alias == aliasName && join.Name != "" {
+							names = append(strings.Split(join.Name, "."), names[len(names)-1])
+							break
+						}
+					}
+
+					if rel, ok := sch.Relationships.Relations[names[0]]; ok {
+						subNameCount := len(names)
+						// nested relation fields
+						relFields := make([]*schema.Field, 0, subNameCount-1)
+						relFields = append(relFields, rel.Field)
+						for _, name := range names[1 : subNameCount-1] {
+							rel = rel.FieldSchema.Relationships.Relations[name]
+							relFields = append(relFields, rel.Field)
+						}
+						// latest name is raw dbname
+						dbName := names[subNameCount-1]
+						if field := rel.FieldSchema.LookUpField(dbName); field != nil && field.Readable {
+							fields[idx] = field
+
+							if len(joinFields) == 0 {
+								joinFields = make([][]*schema.Field, len(columns))
+							}
+							relFields = append(relFields, field)
+							joinFields[idx] = relFields
+							continue
+						}
+					} else if field := sch.FieldsByName[names[0]]; len(names) == 2 && field != nil && field.IndirectFieldType.Kind() == reflect.Struct {
+						// columns of joined derived table, scan into the struct field named as the table alias
+						if fieldSchema, err := schema.Parse(reflect.New(field.IndirectFieldType).Interface(), db.cacheStore, db.NamingStrategy); err == nil {
+							if f := fieldSchema.LookUpField(names[1]); f != nil && f.Readable {
+								fields[idx] = f
+
+								if len(joinFields) == 0 {
+									joinFields = make([][]*schema.Field, len(columns))
+								}
+								joinFields[idx] = []*schema.Field{field, f}
+								continue
+							}
+						}
+					}
+					var val interface{}
+					values[idx] = &val
+				} else {
+					var val interface{}
+					values[idx] = &val
+				}
+			}
+		}
+	}
+	return fields, joinFields
+}
+
+// rowScanner scans the rows into the values of the same type one by one, the columns are matched with the fields
+// of the struct once
+type rowScanner struct {
+	db         *DB
+	values     []interface{}
+	fields     []*schema.Field
+	joinFields [][]*schema.Field
+}
+
+// newRowScanner returns the scanner of the rows into the values of dest type, the rows are scanned with ScanRows
+// unless dest is a pointer to struct
+func (db *DB) newRowScanner(rows *sql.Rows, dest interface{}) *rowScanner {
+	scanner := &rowScanner{db: db}
+	if reflectValue := reflect.ValueOf(dest); reflectValue.Kind() != reflect.Ptr || reflectValue.Elem().Kind() != reflect.Struct {
+		return scanner
+	}
+
+	if err := db.Statement.Parse(dest); err != nil {
+		return scanner
+	}
+	db.Statement.Dest = dest
+
+	columns, err := rows.Columns()
+	if db.AddError(err) != nil {
+		return scanner
+	}
+
+	for i, column := range columns {
+		if v, ok := db.Statement.ColumnMapping[column]; ok {
+			columns[i] = v
+		}
+	}
+
+	scanner.values = make([]interface{}, len(columns))
+	scanner.fields, scanner.joinFields = db.scanFields(columns, scanner.values, reflect.TypeOf(dest).Elem())
+	return scanner
+}
+
+// scan scans the current row into dest
+func (scanner *rowScanner) scan(rows *sql.Rows, dest interface{}) error {
+	if scanner.fields == nil {
+		return scanner.db.ScanRows(rows, dest)
+	}
+
+	reflectValue := reflect.ValueOf(dest).Elem()
+	reflectValue.Set(reflect.Zero(reflectValue.Type()))
+	scanner.db.scanIntoStruct(rows, reflectValue, scanner.values, scanner.fields, scanner.joinFields)
+	return scanner.db.Error
+}
@@ -13,6 +13,7 @@ var regEnLetterAndMidline = regexp.MustCompile(`^[\w-]+$`)
 type CheckConstraint struct {
 	Name       string
 	Constraint string // length(phone) >= 10
+	Enum       bool   // check constraint of the enum values
 	*Field
 }
 
@@ -38,6 +39,11 @@ func (schema *Schema) ParseCheckConstraints() map[string]CheckConstraint {
 				checks[name] = CheckConstraint{Name: name, Constraint: chk, Field: field}
 			}
 		}
+
+		if len(field.EnumValues) > 0 {
+			name := schema.namer.CheckerName(schema.Table, field.DBName+"_enum")
+			checks[name] = CheckConstraint{Name: name, Constraint: field.enumConstraint(), Enum: true, Field: field}
+		}
 	}
 	return checks
 }
@@ -55,6 +55,67 @@ func TestParseCheck(t *testing.T) {
 	}
 }
 
+type PostEnum struct {
+	ID       uint
+	Status   string  `gorm:"enum:draft, published,it's archived;check:status <> ''"`
+	Priority int     `gorm:"enum:1,2,3"`
+	Kind     *string `gorm:"enum:news,blog"`
+}
+
+func TestParseEnumCheckConstraints(t *testing.T) {
+	post, err := schema.Parse(&PostEnum{}, &sync.Map{}, schema.NamingStrategy{})
+	if err != nil {
+		t.Fatalf("failed to parse post enum, got error %v", err)
+	}
+
+	if status := post.LookUpField("Status"); !reflect.DeepEqual(status.EnumValues, []string{"draft", "published", "it's archived"}) {
+		t.Errorf("unexpected enum values %v", status.EnumValues)
+	}
+
+	checks := post.ParseCheckConstraints()
+	results := map[string]schema.CheckConstraint{
+		"chk_post_enums_status":        {Name: "chk_post_enums_status", Constraint: "status <> ''"},
+		"chk_post_enums_status_enum":   {Name: "chk_post_enums_status_enum", Constraint: "status IN ('draft','published','it''s archived')", Enum: true},
+		"chk_post_enums_priority_enum": {Name: "chk_post_enums_priority_enum", Constraint: "priority IN (1,2,3)", Enum: true},
+		"chk_post_enums_kind_enum":     {Name: "chk_post_enums_kind_enum", Constraint: "kind IN ('news','blog')", Enum: true},
+	}
+
+	if len(checks) != len(results) {
+		t.Fatalf("expects %d checks, got %+v", len(results), checks)
+	}
+
+	for k, result := range results {
+		if v, ok := checks[k]; !ok || v.Name != result.Name || v.Constraint != result.Constraint || v.Enum != result.Enum {
+			t.Errorf("check %v should equal, expects %+v, got %+v", k, result, v)
+		}
+	}
+
+	kind, news, unknown := post.LookUpField("Kind"), "news", "unknown"
+	for _, value := range []interface{}{nil, &news, (*string)(nil), "news"} {
+		if !kind.ValidEnumValue(value) {
+			t.Errorf("%#v should be valid enum value", value)
+		}
+	}
+
+	for _, value := range []interface{}{"", &unknown, "News"} {
+		if kind.ValidEnumValue(value) {
+			t.Errorf("%#v should be invalid enum value", value)
+		}
+	}
+
+	if priority := post.LookUpField("Priority"); !priority.ValidEnumValue(2) || priority.ValidEnumValue(int64(4)) {
+		t.Errorf("failed to validate integer enum values")
+	}
+
+	type InvalidEnum struct {
+		Priority int `gorm:"enum:low,high"`
+	}
+
+	if _, err := schema.Parse(&InvalidEnum{}, &sync.Map{}, schema.NamingStrategy{}); err == nil {
+		t.Errorf("should returns error for invalid integer enum values")
+	}
+}
+
 func TestParseUniqueConstraints(t *testing.T) {
 	type UserUnique struct {
 		Name1 string `gorm:"unique"`
@@ -0,0 +1,197 @@
+package schema
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+)
+
+var (
+	// ErrEncryptionKeyNotFound encryption key not found in the keyring
+	ErrEncryptionKeyNotFound = errors.New("encryption key not found")
+	// ErrInvalidCiphertext invalid ciphertext
+	ErrInvalidCiphertext = errors.New("invalid ciphertext")
+)
+
+// Keyring keys of EncryptedSerializer, values are encrypted with the current key, the ciphertexts carry the key ID, so
+// the rows encrypted with the old keys could still be decrypted after rotation as long as the keys kept in the keyring
+type Keyring interface {
+	// CurrentKey returns the ID and the key to encrypt values
+	CurrentKey(ctx context.Context) (id string, key []byte, err error)
+	// Key returns the key of the ID to decrypt values
+	Key(ctx context.Context, id string) (key []byte, err error)
+}
+
+// StaticKeyring keyring with fixed keys, the keys should be 16, 24 or 32 bytes to select AES-128, AES-192 or AES-256
+type StaticKeyring struct {
+	Current string
+	Keys    map[string][]byte
+}
+
+// CurrentKey implements Keyring interface
+func (keyring StaticKeyring) CurrentKey(ctx context.Context) (string, []byte, error) {
+	key, err := keyring.Key(ctx, keyring.Current)
+	return keyring.Current, key, err
+}
+
+// Key implements Keyring interface
+func (keyring StaticKeyring) Key(ctx context.Context, id string) ([]byte, error) {
+	if key, ok := keyring.Keys[id]; ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("%w: %s", ErrEncryptionKeyNotFound, id)
+}
+
+// EncryptedSerializer encrypts values with AES-GCM, values are stored in the form of `<key id>:<base64 ciphertext>`,
+// register it with the keyring to use it, e.g:
+//
+//	schema.RegisterSerializer("encrypted", schema.EncryptedSerializer{Keyring: keyring})
+//
+//	type User struct {
+//	  SSN string `gorm:"serializer:encrypted"`
+//	}
+//
+// In Deterministic mode, the nonce is derived from the key and value, the same value always gets the same ciphertext
+// with the same key, which allows equality lookups by struct conditions like `db.Where(&User{SSN: "xxx"})`, but also
+// reveals which rows have equal values, rows encrypted with the old keys could only be found after re-encrypted
+type EncryptedSerializer struct {
+	Keyring       Keyring
+	Deterministic bool
+}
+
+// Scan implements serializer interface
+func (s EncryptedSerializer) Scan(ctx context.Context, field *Field, dst reflect.Value, dbValue interface{}) (err error) {
+	fieldValue := reflect.New(field.FieldType)
+
+	if dbValue != nil {
+		var ciphertext string
+		switch v := dbValue.(type) {
+		case []byte:
+			ciphertext = string(v)
+		case string:
+			ciphertext = v
+		default:
+			return fmt.Errorf("%w: %#v", ErrInvalidCiphertext, dbValue)
+		}
+
+		if ciphertext != "" {
+			var plaintext []byte
+			if plaintext, err = s.Decrypt(ctx, ciphertext); err != nil {
+				return err
+			}
+
+			if err = json.Unmarshal(plaintext, fieldValue.Interface()); err != nil {
+				return err
+			}
+		}
+	}
+
+	field.ReflectValueOf(ctx, dst).Set(fieldValue.Elem())
+	return
+}
+
+// Value implements serializer interface
+func (s EncryptedSerializer) Value(ctx context.Context, field *Field, dst reflect.Value, fieldValue interface{}) (interface{}, error) {
+	if rv := reflect.ValueOf(fieldValue); !rv.IsValid() || (rv.Kind() == reflect.Ptr && rv.IsNil()) {
+		return nil, nil
+	}
+	return s.Encrypt(ctx, fieldValue)
+}
+
+// Encrypt encrypts the value with the current key of the keyring
+func (s EncryptedSerializer) Encrypt(ctx context.Context, value interface{}) (string, error) {
+	if s.Keyring == nil {
+		return "", fmt.Errorf("%w: keyring required", ErrEncryptionKeyNotFound)
+	}
+
+	plaintext, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+
+	id, key, err := s.Keyring.CurrentKey(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	aead, err := newAEAD(key)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if s.Deterministic {
+		mac := hmac.New(sha256.New, deterministicNonceKey(key))
+		mac.Write(plaintext)
+		copy(nonce, mac.Sum(nil))
+	} else if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", err
+	}
+
+	// the key id is authenticated as additional data
+	sealed := aead.Seal(nonce, nonce, plaintext, []byte(id))
+	return id + ":" + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt decrypts the ciphertext with the key of its key id
+func (s EncryptedSerializer) Decrypt(ctx context.Context, ciphertext string) ([]byte, error) {
+	if s.Keyring == nil {
+		return nil, fmt.Errorf("%w: keyring required", ErrEncryptionKeyNotFound)
+	}
+
+	idx := strings.LastIndexByte(ciphertext, ':')
+	if idx < 0 {
+		return nil, ErrInvalidCiphertext
+	}
+
+	id := ciphertext[:idx]
+	sealed, err := base64.StdEncoding.DecodeString(ciphertext[idx+1:])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCiphertext, err)
+	}
+
+	key, err := s.Keyring.Key(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sealed) < aead.NonceSize() {
+		return nil, ErrInvalidCiphertext
+	}
+
+	plaintext, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], []byte(id))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCiphertext, err)
+	}
+	return plaintext, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// deterministicNonceKey derives the key to generate nonces in deterministic mode, avoid using the encryption key directly
+func deterministicNonceKey(key []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte("gorm encrypted serializer nonce"))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"database/sql/driver"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ValidEnumValue reports whether the value is one of the enum values of the field, nil and the values couldn't be
+// compared like SQL expressions are considered valid
+func (field *Field) ValidEnumValue(value interface{}) bool {
+	if len(field.EnumValues) == 0 || value == nil {
+		return true
+	}
+
+	rv := reflect.ValueOf(value)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return true
+		}
+		rv = rv.Elem()
+	}
+
+	if valuer, ok := rv.Interface().(driver.Valuer); ok {
+		v, err := valuer.Value()
+		if err != nil || v == nil {
+			return true
+		}
+		rv = reflect.ValueOf(v)
+	}
+
+	var str string
+	switch rv.Kind() {
+	case reflect.String:
+		str = rv.String()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		str = strconv.FormatInt(rv.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		str = strconv.FormatUint(rv.Uint(), 10)
+	default:
+		return true
+	}
+
+	for _, v := range field.EnumValues {
+		if v == str {
+			return true
+		}
+	}
+	return false
+}
+
+// enumConstraint returns the check constraint of the enum values, like `status IN ('draft','published')`
+func (field *Field) enumConstraint() string {
+	values := make([]string, len(field.EnumValues))
+	for idx, value := range field.EnumValues {
+		if field.GORMDataType == String {
+			value = "'" + strings.ReplaceAll(value, "'", "''") + "'"
+		}
+		values[idx] = value
+	}
+	return field.DBName + " IN (" + strings.Join(values, ",") + ")"
+}
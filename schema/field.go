@@ -77,6 +77,9 @@ type Field struct {
 	Precision              int
 	Scale                  int
 	IgnoreMigration        bool
+	GeneratedExpression    string // expression of the generated column, like `price * quantity`
+	GeneratedStorage       string // STORED or VIRTUAL, STORED by default as some databases don't support VIRTUAL
+	EnumValues             []string
 	FieldType              reflect.Type
 	IndirectFieldType      reflect.Type
 	StructField            reflect.StructField
@@ -385,6 +388,41 @@ func (schema *Schema) ParseField(fieldStruct reflect.StructField) *Field {
 		}
 	}
 
+	// generated column is computed by the database, e.g: `gorm:"generated:price * quantity;stored"`, it is stored if
+	// not declared as virtual, VIRTUAL requires the database support it, like MySQL, SQLite, SQL Server or Postgres 18+
+	if v, ok := field.TagSettings["GENERATED"]; ok && v != "GENERATED" {
+		field.GeneratedExpression = strings.TrimSpace(v)
+		field.Creatable = false
+		field.Updatable = false
+		field.Readable = true
+
+		field.GeneratedStorage = "STORED"
+		if _, ok := field.TagSettings["VIRTUAL"]; ok {
+			field.GeneratedStorage = "VIRTUAL"
+		}
+	}
+
+	// enum values of string or integer fields, e.g: `gorm:"enum:draft,published,archived"`
+	if v, ok := field.TagSettings["ENUM"]; ok && v != "ENUM" {
+		for _, value := range strings.Split(v, ",") {
+			if value = strings.TrimSpace(value); value != "" {
+				field.EnumValues = append(field.EnumValues, value)
+			}
+		}
+
+		switch field.GORMDataType {
+		case String:
+		case Int, Uint:
+			for _, value := range field.EnumValues {
+				if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+					schema.err = fmt.Errorf("invalid enum value %s for integer field %s", value, field.Name)
+				}
+			}
+		default:
+			schema.err = fmt.Errorf("unsupported enum field %s with data type %s, should be string or integer", field.Name, field.GORMDataType)
+		}
+	}
+
 	// Normal anonymous field or having `EMBEDDED` tag
 	if _, ok := field.TagSettings["EMBEDDED"]; ok || (field.GORMDataType != Time && field.GORMDataType != Bytes && !isValuer &&
 		fieldStruct.Anonymous && (field.Creatable || field.Updatable || field.Readable)) {
@@ -262,6 +262,32 @@ func TestParseFieldWithPermission(t *testing.T) {
 	}
 }
 
+type ProductWithGeneratedColumn struct {
+	ID       uint
+	Price    float64
+	Quantity int
+	Total    float64 `gorm:"generated:price * quantity;stored"`
+	Discount float64 `gorm:"generated:price / 2;virtual"`
+	Code     string  `gorm:"generated:upper(name)"`
+}
+
+func TestParseFieldWithGenerated(t *testing.T) {
+	product, err := schema.Parse(&ProductWithGeneratedColumn{}, &sync.Map{}, schema.NamingStrategy{})
+	if err != nil {
+		t.Fatalf("Failed to parse product with generated column, got error %v", err)
+	}
+
+	fields := []*schema.Field{
+		{Name: "Total", DBName: "total", BindNames: []string{"Total"}, DataType: schema.Float, Size: 64, Tag: `gorm:"generated:price * quantity;stored"`, Creatable: false, Updatable: false, Readable: true, GeneratedExpression: "price * quantity", GeneratedStorage: "STORED"},
+		{Name: "Discount", DBName: "discount", BindNames: []string{"Discount"}, DataType: schema.Float, Size: 64, Tag: `gorm:"generated:price / 2;virtual"`, Creatable: false, Updatable: false, Readable: true, GeneratedExpression: "price / 2", GeneratedStorage: "VIRTUAL"},
+		{Name: "Code", DBName: "code", BindNames: []string{"Code"}, DataType: schema.String, Tag: `gorm:"generated:upper(name)"`, Creatable: false, Updatable: false, Readable: true, GeneratedExpression: "upper(name)", GeneratedStorage: "STORED"},
+	}
+
+	for _, f := range fields {
+		checkSchemaField(t, product, f, func(f *schema.Field) {})
+	}
+}
+
 type (
 	ID      int64
 	INT     int
@@ -0,0 +1,330 @@
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Graph the tables and relationships of the parsed models, it could be exported as JSON or entity-relationship
+// diagrams in Mermaid or Graphviz DOT format, tables and relationships are sorted by name for stable outputs
+type Graph struct {
+	Tables        []GraphTable        `json:"tables"`
+	Relationships []GraphRelationship `json:"relationships"`
+}
+
+// GraphTable table of the graph, join tables of many2many relationships are included
+type GraphTable struct {
+	Name      string        `json:"name"`
+	Model     string        `json:"model"`
+	JoinTable bool          `json:"join_table,omitempty"`
+	Columns   []GraphColumn `json:"columns"`
+	Indexes   []GraphIndex  `json:"indexes,omitempty"`
+	Checks    []GraphCheck  `json:"checks,omitempty"`
+}
+
+// GraphColumn column of the table
+type GraphColumn struct {
+	Name          string   `json:"name"`
+	Field         string   `json:"field"`
+	DataType      string   `json:"data_type"`
+	Size          int      `json:"size,omitempty"`
+	Precision     int      `json:"precision,omitempty"`
+	Scale         int      `json:"scale,omitempty"`
+	PrimaryKey    bool     `json:"primary_key,omitempty"`
+	ForeignKey    bool     `json:"foreign_key,omitempty"`
+	AutoIncrement bool     `json:"auto_increment,omitempty"`
+	NotNull       bool     `json:"not_null,omitempty"`
+	Unique        bool     `json:"unique,omitempty"`
+	Default       string   `json:"default,omitempty"`
+	Comment       string   `json:"comment,omitempty"`
+	Enum          []string `json:"enum,omitempty"`
+}
+
+// GraphIndex index of the table
+type GraphIndex struct {
+	Name    string   `json:"name"`
+	Class   string   `json:"class,omitempty"`
+	Type    string   `json:"type,omitempty"`
+	Columns []string `json:"columns"`
+	Where   string   `json:"where,omitempty"`
+}
+
+// GraphCheck check constraint of the table
+type GraphCheck struct {
+	Name       string `json:"name"`
+	Constraint string `json:"constraint"`
+}
+
+// GraphRelationship relationship between tables, foreign keys and references are in the form of `table.column`
+type GraphRelationship struct {
+	Name        string           `json:"name"`
+	Type        RelationshipType `json:"type"`
+	Cardinality string           `json:"cardinality"`
+	From        string           `json:"from"`
+	To          string           `json:"to"`
+	JoinTable   string           `json:"join_table,omitempty"`
+	ForeignKeys []string         `json:"foreign_keys"`
+	References  []string         `json:"references"`
+	Polymorphic string           `json:"polymorphic,omitempty"`
+}
+
+var relationshipCardinalities = map[RelationshipType]string{
+	HasOne:    "one-to-one",
+	HasMany:   "one-to-many",
+	BelongsTo: "many-to-one",
+	Many2Many: "many-to-many",
+}
+
+// ExportGraph exports the graph of the parsed models
+func ExportGraph(schemas ...*Schema) *Graph {
+	var (
+		graph       = &Graph{Tables: []GraphTable{}, Relationships: []GraphRelationship{}}
+		tables      = map[string]*Schema{}
+		joinTables  = map[string]bool{}
+		foreignKeys = map[string]bool{}
+	)
+
+	for _, schema := range schemas {
+		if _, ok := tables[schema.Table]; !ok {
+			tables[schema.Table] = schema
+		}
+	}
+
+	for _, schema := range schemas {
+		if tables[schema.Table] != schema {
+			continue
+		}
+
+		names := make([]string, 0, len(schema.Relationships.Relations))
+		for name, rel := range schema.Relationships.Relations {
+			// skip the relationships referenced by other schemas, like `_User_Pets`
+			if rel.Schema == schema {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			rel := schema.Relationships.Relations[name]
+			relationship := GraphRelationship{
+				Name:        rel.Name,
+				Type:        rel.Type,
+				Cardinality: relationshipCardinalities[rel.Type],
+				From:        rel.Schema.Table,
+				To:          rel.FieldSchema.Table,
+				ForeignKeys: []string{},
+				References:  []string{},
+			}
+
+			if rel.JoinTable != nil {
+				relationship.JoinTable = rel.JoinTable.Table
+				if _, ok := tables[rel.JoinTable.Table]; !ok {
+					tables[rel.JoinTable.Table] = rel.JoinTable
+					joinTables[rel.JoinTable.Table] = true
+				}
+			}
+
+			if rel.Polymorphic != nil {
+				relationship.Polymorphic = rel.Polymorphic.Value
+			}
+
+			for _, ref := range rel.References {
+				foreignKey := ref.ForeignKey.Schema.Table + "." + ref.ForeignKey.DBName
+				foreignKeys[foreignKey] = true
+				relationship.ForeignKeys = append(relationship.ForeignKeys, foreignKey)
+				if ref.PrimaryKey != nil {
+					relationship.References = append(relationship.References, ref.PrimaryKey.Schema.Table+"."+ref.PrimaryKey.DBName)
+				} else {
+					relationship.References = append(relationship.References, fmt.Sprintf("'%s'", ref.PrimaryValue))
+				}
+			}
+
+			graph.Relationships = append(graph.Relationships, relationship)
+		}
+	}
+
+	sort.SliceStable(graph.Relationships, func(i, j int) bool {
+		if graph.Relationships[i].From != graph.Relationships[j].From {
+			return graph.Relationships[i].From < graph.Relationships[j].From
+		}
+		return graph.Relationships[i].Name < graph.Relationships[j].Name
+	})
+
+	names := make([]string, 0, len(tables))
+	for name := range tables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		graph.Tables = append(graph.Tables, exportTable(tables[name], joinTables[name], foreignKeys))
+	}
+
+	return graph
+}
+
+func exportTable(schema *Schema, joinTable bool, foreignKeys map[string]bool) GraphTable {
+	table := GraphTable{Name: schema.Table, Model: schema.Name, JoinTable: joinTable, Columns: []GraphColumn{}}
+
+	for _, dbName := range schema.DBNames {
+		field := schema.FieldsByDBName[dbName]
+		if field.IgnoreMigration {
+			continue
+		}
+
+		column := GraphColumn{
+			Name:          field.DBName,
+			Field:         field.Name,
+			DataType:      string(field.DataType),
+			Size:          field.Size,
+			Precision:     field.Precision,
+			Scale:         field.Scale,
+			PrimaryKey:    field.PrimaryKey,
+			AutoIncrement: field.AutoIncrement,
+			NotNull:       field.NotNull,
+			Unique:        field.Unique,
+			ForeignKey:    foreignKeys[schema.Table+"."+field.DBName],
+			Comment:       field.Comment,
+			Enum:          field.EnumValues,
+		}
+
+		if field.HasDefaultValue {
+			column.Default = field.DefaultValue
+		}
+
+		table.Columns = append(table.Columns, column)
+	}
+
+	for _, idx := range schema.ParseIndexes() {
+		index := GraphIndex{Name: idx.Name, Class: idx.Class, Type: idx.Type, Where: idx.Where, Columns: []string{}}
+		for _, option := range idx.Fields {
+			if option.Expression != "" {
+				index.Columns = append(index.Columns, option.Expression)
+			} else if option.Field != nil {
+				index.Columns = append(index.Columns, option.DBName)
+			}
+		}
+		table.Indexes = append(table.Indexes, index)
+	}
+	sort.Slice(table.Indexes, func(i, j int) bool { return table.Indexes[i].Name < table.Indexes[j].Name })
+
+	for name, chk := range schema.ParseCheckConstraints() {
+		table.Checks = append(table.Checks, GraphCheck{Name: name, Constraint: chk.Constraint})
+	}
+	sort.Slice(table.Checks, func(i, j int) bool { return table.Checks[i].Name < table.Checks[j].Name })
+
+	return table
+}
+
+// JSON returns the indented JSON document of the graph
+func (graph *Graph) JSON() ([]byte, error) {
+	return json.MarshalIndent(graph, "", "  ")
+}
+
+var diagramIdentifierRegexp = regexp.MustCompile(`[^\w]+`)
+
+// diagramIdentifier replaces the characters not allowed in the identifiers of diagrams
+func diagramIdentifier(name string) string {
+	if name = strings.Trim(diagramIdentifierRegexp.ReplaceAllString(name, "_"), "_"); name == "" {
+		return "_"
+	}
+	return name
+}
+
+func (column GraphColumn) keys() (keys []string) {
+	if column.PrimaryKey {
+		keys = append(keys, "PK")
+	}
+	if column.ForeignKey {
+		keys = append(keys, "FK")
+	}
+	if column.Unique {
+		keys = append(keys, "UK")
+	}
+	return
+}
+
+// Mermaid returns the entity-relationship diagram in Mermaid format, many2many relationships are drawn through
+// their join tables
+func (graph *Graph) Mermaid() string {
+	var builder strings.Builder
+	builder.WriteString("erDiagram\n")
+
+	for _, table := range graph.Tables {
+		builder.WriteString("    " + diagramIdentifier(table.Name) + " {\n")
+		for _, column := range table.Columns {
+			builder.WriteString("        " + diagramIdentifier(column.DataType) + " " + diagramIdentifier(column.Name))
+			if keys := column.keys(); len(keys) > 0 {
+				builder.WriteString(" " + strings.Join(keys, ","))
+			}
+			if column.Comment != "" {
+				builder.WriteString(fmt.Sprintf(" %q", strings.ReplaceAll(column.Comment, `"`, `'`)))
+			}
+			builder.WriteByte('\n')
+		}
+		builder.WriteString("    }\n")
+	}
+
+	for _, rel := range graph.Relationships {
+		from, to, label := diagramIdentifier(rel.From), diagramIdentifier(rel.To), fmt.Sprintf("%q", rel.Name)
+		switch rel.Type {
+		case HasOne:
+			builder.WriteString("    " + from + " ||--o| " + to + " : " + label + "\n")
+		case HasMany:
+			builder.WriteString("    " + from + " ||--o{ " + to + " : " + label + "\n")
+		case BelongsTo:
+			builder.WriteString("    " + from + " }o--|| " + to + " : " + label + "\n")
+		case Many2Many:
+			if rel.JoinTable != "" {
+				joinTable := diagramIdentifier(rel.JoinTable)
+				builder.WriteString("    " + from + " ||--o{ " + joinTable + " : " + label + "\n")
+				builder.WriteString("    " + joinTable + " }o--|| " + to + " : " + label + "\n")
+			} else {
+				builder.WriteString("    " + from + " }o--o{ " + to + " : " + label + "\n")
+			}
+		}
+	}
+
+	return builder.String()
+}
+
+var dotRecordReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "{", `\{`, "}", `\}`, "|", `\|`, "<", `\<`, ">", `\>`)
+
+// DOT returns the entity-relationship diagram in Graphviz DOT format, many2many relationships are drawn through
+// their join tables
+func (graph *Graph) DOT() string {
+	var builder strings.Builder
+	builder.WriteString("digraph schema {\n    rankdir=LR;\n    node [shape=record];\n")
+
+	for _, table := range graph.Tables {
+		builder.WriteString(fmt.Sprintf("    %q [label=\"{%s", table.Name, dotRecordReplacer.Replace(table.Name)))
+		if table.JoinTable {
+			builder.WriteString(" (join table)")
+		}
+		builder.WriteString("|")
+		for _, column := range table.Columns {
+			builder.WriteString(dotRecordReplacer.Replace(column.Name + " : " + column.DataType))
+			if keys := column.keys(); len(keys) > 0 {
+				builder.WriteString(" " + strings.Join(keys, ","))
+			}
+			builder.WriteString(`\l`)
+		}
+		builder.WriteString("}\"];\n")
+	}
+
+	for _, rel := range graph.Relationships {
+		label := fmt.Sprintf("%s (%s)", rel.Name, rel.Cardinality)
+		if rel.JoinTable != "" {
+			builder.WriteString(fmt.Sprintf("    %q -> %q [label=%q];\n", rel.From, rel.JoinTable, label))
+			builder.WriteString(fmt.Sprintf("    %q -> %q [label=%q];\n", rel.JoinTable, rel.To, label))
+		} else {
+			builder.WriteString(fmt.Sprintf("    %q -> %q [label=%q];\n", rel.From, rel.To, label))
+		}
+	}
+
+	builder.WriteString("}\n")
+	return builder.String()
+}
@@ -0,0 +1,162 @@
+package schema_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"sync"
+	"testing"
+
+	"gorm.io/gorm/schema"
+)
+
+type GraphUser struct {
+	ID        uint
+	Name      string `gorm:"size:100;index;comment:user name"`
+	CompanyID int
+	Company   GraphCompany
+	Pets      []GraphPet
+	Languages []GraphLanguage `gorm:"many2many:graph_user_languages"`
+}
+
+type GraphCompany struct {
+	ID   int
+	Name string `gorm:"unique"`
+}
+
+type GraphPet struct {
+	ID          uint
+	GraphUserID uint
+	Name        string `gorm:"check:name <> ''"`
+}
+
+type GraphLanguage struct {
+	Code string `gorm:"primaryKey"`
+}
+
+func parseGraph(t *testing.T) *schema.Graph {
+	var (
+		cacheStore = &sync.Map{}
+		schemas    []*schema.Schema
+	)
+
+	for _, model := range []interface{}{&GraphUser{}, &GraphPet{}, &GraphCompany{}, &GraphLanguage{}} {
+		s, err := schema.Parse(model, cacheStore, schema.NamingStrategy{})
+		if err != nil {
+			t.Fatalf("failed to parse %T, got error %v", model, err)
+		}
+		schemas = append(schemas, s)
+	}
+
+	return schema.ExportGraph(schemas...)
+}
+
+func TestExportGraph(t *testing.T) {
+	graph := parseGraph(t)
+
+	var tables []string
+	for _, table := range graph.Tables {
+		tables = append(tables, table.Name)
+	}
+
+	if expects := []string{"graph_companies", "graph_languages", "graph_pets", "graph_user_languages", "graph_users"}; !reflect.DeepEqual(tables, expects) {
+		t.Fatalf("expects tables %v, got %v", expects, tables)
+	}
+
+	if !graph.Tables[3].JoinTable || graph.Tables[4].JoinTable {
+		t.Errorf("only graph_user_languages should be join table")
+	}
+
+	users := graph.Tables[4]
+	if expects := (schema.GraphColumn{Name: "name", Field: "Name", DataType: "string", Size: 100, Comment: "user name"}); !reflect.DeepEqual(users.Columns[1], expects) {
+		t.Errorf("expects column %+v, got %+v", expects, users.Columns[1])
+	}
+
+	if !users.Columns[2].ForeignKey || users.Columns[0].ForeignKey {
+		t.Errorf("only company_id should be foreign key, got %+v", users.Columns)
+	}
+
+	if len(users.Indexes) != 1 || users.Indexes[0].Name != "idx_graph_users_name" || !reflect.DeepEqual(users.Indexes[0].Columns, []string{"name"}) {
+		t.Errorf("unexpected indexes %+v", users.Indexes)
+	}
+
+	if checks := graph.Tables[2].Checks; len(checks) != 1 || checks[0].Constraint != "name <> ''" {
+		t.Errorf("unexpected checks %+v", checks)
+	}
+
+	expects := []schema.GraphRelationship{
+		{Name: "Company", Type: schema.BelongsTo, Cardinality: "many-to-one", From: "graph_users", To: "graph_companies", ForeignKeys: []string{"graph_users.company_id"}, References: []string{"graph_companies.id"}},
+		{Name: "Languages", Type: schema.Many2Many, Cardinality: "many-to-many", From: "graph_users", To: "graph_languages", JoinTable: "graph_user_languages", ForeignKeys: []string{"graph_user_languages.graph_user_id", "graph_user_languages.graph_language_code"}, References: []string{"graph_users.id", "graph_languages.code"}},
+		{Name: "Pets", Type: schema.HasMany, Cardinality: "one-to-many", From: "graph_users", To: "graph_pets", ForeignKeys: []string{"graph_pets.graph_user_id"}, References: []string{"graph_users.id"}},
+	}
+
+	if !reflect.DeepEqual(graph.Relationships, expects) {
+		t.Errorf("expects relationships %+v, got %+v", expects, graph.Relationships)
+	}
+
+	data, err := graph.JSON()
+	if err != nil {
+		t.Fatalf("failed to export json, got error %v", err)
+	}
+
+	var result schema.Graph
+	if err := json.Unmarshal(data, &result); err != nil || !reflect.DeepEqual(&result, graph) {
+		t.Errorf("exported json should be decoded to the graph, got error %v", err)
+	}
+
+	if again, _ := parseGraph(t).JSON(); string(again) != string(data) {
+		t.Errorf("exported json should be stable")
+	}
+}
+
+func TestExportGraphMermaid(t *testing.T) {
+	expects := `erDiagram
+    graph_companies {
+        int id PK
+        string name UK
+    }
+    graph_languages {
+        string code PK
+    }
+    graph_pets {
+        uint id PK
+        uint graph_user_id FK
+        string name
+    }
+    graph_user_languages {
+        uint graph_user_id PK,FK
+        string graph_language_code PK,FK
+    }
+    graph_users {
+        uint id PK
+        string name "user name"
+        int company_id FK
+    }
+    graph_users }o--|| graph_companies : "Company"
+    graph_users ||--o{ graph_user_languages : "Languages"
+    graph_user_languages }o--|| graph_languages : "Languages"
+    graph_users ||--o{ graph_pets : "Pets"
+`
+	if result := parseGraph(t).Mermaid(); result != expects {
+		t.Errorf("expects mermaid\n%v\ngot\n%v", expects, result)
+	}
+}
+
+func TestExportGraphDOT(t *testing.T) {
+	expects := `digraph schema {
+    rankdir=LR;
+    node [shape=record];
+    "graph_companies" [label="{graph_companies|id : int PK\lname : string UK\l}"];
+    "graph_languages" [label="{graph_languages|code : string PK\l}"];
+    "graph_pets" [label="{graph_pets|id : uint PK\lgraph_user_id : uint FK\lname : string\l}"];
+    "graph_user_languages" [label="{graph_user_languages (join table)|graph_user_id : uint PK,FK\lgraph_language_code : string PK,FK\l}"];
+    "graph_users" [label="{graph_users|id : uint PK\lname : string\lcompany_id : int FK\l}"];
+    "graph_users" -> "graph_companies" [label="Company (many-to-one)"];
+    "graph_users" -> "graph_user_languages" [label="Languages (many-to-many)"];
+    "graph_user_languages" -> "graph_languages" [label="Languages (many-to-many)"];
+    "graph_users" -> "graph_pets" [label="Pets (one-to-many)"];
+}
+`
+	if result := parseGraph(t).DOT(); result != expects {
+		t.Errorf("expects dot\n%v\ngot\n%v", expects, result)
+	}
+}
@@ -387,6 +387,10 @@ func ParseWithSpecialTableName(dest interface{}, cacheStore *sync.Map, namer Nam
 		}
 	}
 
+	if _, strict := cacheStore.Load(strictTagsKey{}); strict && schema.err == nil {
+		schema.err = schema.validateTagSettings()
+	}
+
 	return schema, schema.err
 }
 
@@ -2,8 +2,10 @@ package schema
 
 import (
 	"context"
+	"errors"
 	"math"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 )
@@ -211,3 +213,55 @@ func TestUnixSecondSerializer_Value(t *testing.T) {
 		})
 	}
 }
+
+func TestEncryptedSerializer(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		keyring = StaticKeyring{Current: "v1", Keys: map[string][]byte{
+			"v1": []byte("0123456789abcdef0123456789abcdef"),
+			"v2": []byte("fedcba9876543210"),
+		}}
+		serializer = EncryptedSerializer{Keyring: keyring}
+	)
+
+	ciphertext, err := serializer.Encrypt(ctx, "jinzhu")
+	if err != nil {
+		t.Fatalf("failed to encrypt, got error %v", err)
+	}
+
+	if !strings.HasPrefix(ciphertext, "v1:") || strings.Contains(ciphertext, "jinzhu") {
+		t.Errorf("ciphertext should carry key id and hide the value, got %v", ciphertext)
+	}
+
+	if another, _ := serializer.Encrypt(ctx, "jinzhu"); another == ciphertext {
+		t.Errorf("ciphertexts should be different in randomized mode")
+	}
+
+	serializer.Keyring = StaticKeyring{Current: "v2", Keys: keyring.Keys}
+	if plaintext, err := serializer.Decrypt(ctx, ciphertext); err != nil || string(plaintext) != `"jinzhu"` {
+		t.Errorf("should decrypt with the key of the ciphertext after rotation, got %s, error %v", plaintext, err)
+	}
+
+	if rotated, _ := serializer.Encrypt(ctx, "jinzhu"); !strings.HasPrefix(rotated, "v2:") {
+		t.Errorf("should encrypt with the current key, got %v", rotated)
+	}
+
+	tampered := "v2" + strings.TrimPrefix(ciphertext, "v1")
+	if _, err := serializer.Decrypt(ctx, tampered); !errors.Is(err, ErrInvalidCiphertext) {
+		t.Errorf("should fail to decrypt tampered ciphertext, got error %v", err)
+	}
+
+	if _, err := serializer.Decrypt(ctx, "v3:"+strings.TrimPrefix(ciphertext, "v1:")); !errors.Is(err, ErrEncryptionKeyNotFound) {
+		t.Errorf("should returns key not found error, got error %v", err)
+	}
+
+	serializer.Deterministic = true
+	deterministic, _ := serializer.Encrypt(ctx, "jinzhu")
+	if another, _ := serializer.Encrypt(ctx, "jinzhu"); another != deterministic {
+		t.Errorf("ciphertexts should be same in deterministic mode, got %v, %v", deterministic, another)
+	}
+
+	if another, _ := serializer.Encrypt(ctx, "jinzhu2"); another == deterministic {
+		t.Errorf("ciphertexts of different values should be different in deterministic mode")
+	}
+}
@@ -0,0 +1,189 @@
+package schema
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// ErrInvalidTagSetting invalid tag setting
+var ErrInvalidTagSetting = errors.New("invalid tag setting")
+
+// TagSettingErrors errors of the invalid tag settings found in strict mode
+type TagSettingErrors []error
+
+func (errs TagSettingErrors) Error() string {
+	messages := make([]string, len(errs))
+	for idx, err := range errs {
+		messages[idx] = err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Is reports whether any error matches the target
+func (errs TagSettingErrors) Is(target error) bool {
+	for _, err := range errs {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+type strictTagsKey struct{}
+
+// EnableStrictTags enables strict mode for the schemas parsed with the cache store, unknown tag keys, conflicting
+// settings and malformed relationship tags are reported as errors when parsing
+func EnableStrictTags(cacheStore *sync.Map) {
+	cacheStore.Store(strictTagsKey{}, true)
+}
+
+var (
+	tagKeysMux sync.RWMutex
+	tagKeys    = map[string]bool{
+		"-": true, "->": true, "<-": true, "AUTOCREATETIME": true, "AUTOINCREMENT": true, "AUTOINCREMENTINCREMENT": true,
+		"AUTOUPDATETIME": true, "BELONGSTO": true, "CHECK": true, "COLUMN": true, "COMMENT": true, "CONSTRAINT": true,
+		"DEFAULT": true, "EMBEDDED": true, "EMBEDDEDPREFIX": true, "ENUM": true, "FOREIGNKEY": true, "GENERATED": true, "INDEX": true,
+		"JOINFOREIGNKEY": true, "JOINREFERENCES": true, "JSON": true, "MANY2MANY": true, "NOT NULL": true, "NOTNULL": true,
+		"POLYMORPHIC": true, "POLYMORPHICID": true, "POLYMORPHICTYPE": true, "POLYMORPHICVALUE": true, "PRECISION": true,
+		"PRIMARYKEY": true, "PRIMARY_KEY": true, "PROJECTION": true, "REFERENCES": true, "SCALE": true, "SERIALIZER": true,
+		"SIZE": true, "STORED": true, "TYPE": true, "UNIQUE": true, "UNIQUEINDEX": true, "VIRTUAL": true, "ZEROVALUE": true,
+	}
+
+	// tag keys require a value, like `column:name`
+	valuedTagKeys = []string{
+		"CHECK", "COLUMN", "COMMENT", "CONSTRAINT", "DEFAULT", "EMBEDDEDPREFIX", "ENUM", "GENERATED", "PRECISION", "PROJECTION",
+		"SCALE", "SERIALIZER", "SIZE", "TYPE",
+	}
+
+	relationshipTagKeys = []string{
+		"FOREIGNKEY", "JOINFOREIGNKEY", "JOINREFERENCES", "MANY2MANY", "POLYMORPHIC", "POLYMORPHICID",
+		"POLYMORPHICTYPE", "POLYMORPHICVALUE", "REFERENCES",
+	}
+)
+
+// RegisterTagKeys registers the tag keys used by plugins or dialectors, they are accepted in strict mode
+func RegisterTagKeys(keys ...string) {
+	tagKeysMux.Lock()
+	defer tagKeysMux.Unlock()
+
+	for _, key := range keys {
+		tagKeys[strings.ToUpper(key)] = true
+	}
+}
+
+// validateTagSettings validates the tag settings of the fields in strict mode
+func (schema *Schema) validateTagSettings() error {
+	var errs TagSettingErrors
+	invalid := func(field *Field, format string, args ...interface{}) {
+		errs = append(errs, fmt.Errorf("%w: %s.%s: %s", ErrInvalidTagSetting, schema.Name, field.Name, fmt.Sprintf(format, args...)))
+	}
+
+	tagKeysMux.RLock()
+	defer tagKeysMux.RUnlock()
+
+	for _, field := range schema.Fields {
+		keys := make([]string, 0, len(field.TagSettings))
+		for key := range field.TagSettings {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			if !tagKeys[key] {
+				invalid(field, "unknown tag key %q", key)
+			}
+		}
+
+		for _, key := range valuedTagKeys {
+			if value, ok := field.TagSettings[key]; ok && (value == key || strings.TrimSpace(value) == "") {
+				invalid(field, "missing value of tag key %q", key)
+			}
+		}
+
+		if _, ok := field.TagSettings["-"]; ok {
+			continue
+		}
+
+		if field.PrimaryKey && (!field.Readable || !field.Creatable) {
+			invalid(field, "primary key should be readable and creatable")
+		}
+
+		if field.GeneratedExpression != "" {
+			if field.PrimaryKey || field.HasDefaultValue {
+				invalid(field, "generated column couldn't be primary key or have default value")
+			}
+
+			if _, ok := field.TagSettings["STORED"]; ok {
+				if _, ok := field.TagSettings["VIRTUAL"]; ok {
+					invalid(field, "generated column couldn't be both stored and virtual")
+				}
+			}
+		} else if _, ok := field.TagSettings["STORED"]; ok {
+			invalid(field, "stored without generated")
+		} else if _, ok := field.TagSettings["VIRTUAL"]; ok {
+			invalid(field, "virtual without generated")
+		}
+
+		if len(field.EnumValues) > 0 && field.DefaultValueInterface != nil && !field.ValidEnumValue(field.DefaultValueInterface) {
+			invalid(field, "default value %v isn't one of the enum values", field.DefaultValueInterface)
+		}
+
+		if value, ok := field.TagSettings["AUTOINCREMENT"]; ok && !strings.EqualFold(value, "false") && field.GORMDataType != Int && field.GORMDataType != Uint {
+			invalid(field, "autoIncrement on %s field", field.FieldType)
+		}
+
+		for _, key := range []string{"AUTOCREATETIME", "AUTOUPDATETIME"} {
+			if value, ok := field.TagSettings[key]; ok && !strings.EqualFold(value, "false") && field.GORMDataType != Time && field.GORMDataType != Int && field.GORMDataType != Uint {
+				invalid(field, "%s on %s field", strings.ToLower(key), field.FieldType)
+			}
+		}
+
+		var relationshipKeys []string
+		for _, key := range relationshipTagKeys {
+			if value, ok := field.TagSettings[key]; ok {
+				relationshipKeys = append(relationshipKeys, key)
+				if value == key || strings.TrimSpace(value) == "" {
+					invalid(field, "missing value of relationship tag key %q", key)
+				}
+			}
+		}
+
+		if len(relationshipKeys) == 0 {
+			continue
+		}
+
+		if _, ok := schema.Relationships.Relations[field.Name]; !ok {
+			invalid(field, "relationship tag keys %v on non-relationship field", relationshipKeys)
+			continue
+		}
+
+		if _, ok := field.TagSettings["MANY2MANY"]; !ok {
+			for _, key := range []string{"JOINFOREIGNKEY", "JOINREFERENCES"} {
+				if _, ok := field.TagSettings[key]; ok {
+					invalid(field, "%q requires many2many", key)
+				}
+			}
+		}
+
+		for _, keys := range [][2]string{{"FOREIGNKEY", "REFERENCES"}, {"JOINFOREIGNKEY", "JOINREFERENCES"}} {
+			foreignKeys, references := toColumns(field.TagSettings[keys[0]]), toColumns(field.TagSettings[keys[1]])
+			if len(foreignKeys) > 0 && len(references) > 0 && len(foreignKeys) != len(references) {
+				invalid(field, "%d %s mismatch %d %s", len(foreignKeys), strings.ToLower(keys[0]), len(references), strings.ToLower(keys[1]))
+			}
+		}
+
+		if _, ok := field.TagSettings["POLYMORPHIC"]; ok {
+			if _, ok := field.TagSettings["FOREIGNKEY"]; ok {
+				invalid(field, "polymorphic conflicts with foreignKey")
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package schema_test
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	"gorm.io/gorm/schema"
+	"gorm.io/gorm/utils/tests"
+)
+
+func strictCacheStore() *sync.Map {
+	cacheStore := &sync.Map{}
+	schema.EnableStrictTags(cacheStore)
+	return cacheStore
+}
+
+func TestParseStrictTags(t *testing.T) {
+	for _, model := range []interface{}{&tests.User{}, &tests.Pet{}, &tests.Toy{}, &tests.Company{}, &tests.Language{}, &tests.Coupon{}, &tests.Order{}} {
+		if _, err := schema.Parse(model, strictCacheStore(), schema.NamingStrategy{}); err != nil {
+			t.Errorf("failed to parse %T in strict mode, got error %v", model, err)
+		}
+	}
+}
+
+type StrictTagsUser struct {
+	ID        string `gorm:"primaryKey;autoIncrement"`
+	Name      string `gorm:"colum:user_name"`
+	Age       uint   `gorm:"size"`
+	Code      string `gorm:"primaryKey;->:false"`
+	Birthday  bool   `gorm:"autoCreateTime"`
+	CompanyID int    `gorm:"foreignKey:CompanyRefer"`
+	Company   StrictTagsCompany
+	Languages []StrictTagsLanguage `gorm:"joinForeignKey:UserRefer"`
+}
+
+type StrictTagsCompany struct {
+	ID   int
+	Name string
+}
+
+type StrictTagsLanguage struct {
+	ID               int
+	StrictTagsUserID string
+}
+
+func TestParseStrictTagsErrors(t *testing.T) {
+	_, err := schema.Parse(&StrictTagsUser{}, strictCacheStore(), schema.NamingStrategy{})
+	if !errors.Is(err, schema.ErrInvalidTagSetting) {
+		t.Fatalf("expects invalid tag setting error, got %v", err)
+	}
+
+	var errs schema.TagSettingErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("expects tag setting errors, got %T", err)
+	}
+
+	expects := []string{
+		"StrictTagsUser.ID: autoIncrement on string field",
+		`StrictTagsUser.Name: unknown tag key "COLUM"`,
+		`StrictTagsUser.Age: missing value of tag key "SIZE"`,
+		"StrictTagsUser.Code: primary key should be readable and creatable",
+		"StrictTagsUser.Birthday: autocreatetime on bool field",
+		"StrictTagsUser.CompanyID: relationship tag keys [FOREIGNKEY] on non-relationship field",
+		`StrictTagsUser.Languages: "JOINFOREIGNKEY" requires many2many`,
+	}
+
+	if len(errs) != len(expects) {
+		t.Fatalf("expects %d errors, got %d: %v", len(expects), len(errs), err)
+	}
+
+	for _, expect := range expects {
+		if !strings.Contains(err.Error(), expect) {
+			t.Errorf("expects error %q, got %v", expect, err)
+		}
+	}
+
+	if _, err := schema.Parse(&StrictTagsUser{}, &sync.Map{}, schema.NamingStrategy{}); err != nil {
+		t.Errorf("should not validate tags without strict mode, got error %v", err)
+	}
+}
+
+type StrictTagsPlugin struct {
+	ID   int
+	Name string `gorm:"encrypted"`
+}
+
+func TestParseStrictTagsWithRegisteredKeys(t *testing.T) {
+	if _, err := schema.Parse(&StrictTagsPlugin{}, strictCacheStore(), schema.NamingStrategy{}); !errors.Is(err, schema.ErrInvalidTagSetting) {
+		t.Fatalf("expects invalid tag setting error for unregistered key, got %v", err)
+	}
+
+	schema.RegisterTagKeys("encrypted")
+
+	if _, err := schema.Parse(&StrictTagsPlugin{}, strictCacheStore(), schema.NamingStrategy{}); err != nil {
+		t.Fatalf("failed to parse with registered tag key, got error %v", err)
+	}
+}
@@ -166,5 +166,6 @@ func (sd SoftDeleteDeleteClause) ModifyStatement(stmt *Statement) {
 		SoftDeleteQueryClause(sd).ModifyStatement(stmt)
 		stmt.AddClauseIfNotExists(clause.Update{})
 		stmt.Build(stmt.DB.Callback().Update().Clauses...)
+		stmt.BuildComment()
 	}
 }
@@ -41,6 +41,7 @@ type Statement struct {
 	Context              context.Context
 	RaiseErrorOnNotFound bool
 	SkipHooks            bool
+	UpdatingInBatches    bool // the rows of the model slice are updated with their own values, see DB.UpdatesInBatches
 	SQL                  strings.Builder
 	Vars                 []interface{}
 	CurDestIndex         int
@@ -48,6 +49,7 @@ type Statement struct {
 	assigns              []interface{}
 	scopes               []func(*DB) *DB
 	Result               *result
+	subquery             bool
 }
 
 type join struct {
@@ -164,6 +166,12 @@ func (stmt *Statement) QuoteTo(writer clause.Writer, field interface{}) {
 	}
 }
 
+// ClauseBuilder returns the clause builder registered with name by the dialect
+func (stmt *Statement) ClauseBuilder(name string) (clause.ClauseBuilder, bool) {
+	b, ok := stmt.DB.ClauseBuilders[name]
+	return b, ok
+}
+
 // Quote returns quoted value
 func (stmt *Statement) Quote(field interface{}) string {
 	var builder strings.Builder
@@ -214,6 +222,7 @@ func (stmt *Statement) AddVar(writer clause.Writer, vars ...interface{}) {
 			cv := v.getInstance()
 
 			subdb := cv.Session(&Session{Logger: logger.Discard, DryRun: true}).getInstance()
+			subdb.Statement.subquery = true
 			if cv.Statement.SQL.Len() > 0 {
 				var (
 					vars = subdb.Statement.Vars
@@ -491,12 +500,46 @@ func (stmt *Statement) BuildCondition(query interface{}, args ...interface{}) []
 
 // Build build sql with clauses names
 func (stmt *Statement) Build(clauses ...string) {
-	var firstClauseWritten bool
+	var (
+		firstClauseWritten bool
+		keywordHinted      bool
+		hints              = stmt.buildHints()
+	)
 
 	for _, name := range clauses {
 		if c, ok := stmt.Clauses[name]; ok {
 			if firstClauseWritten {
 				stmt.WriteByte(' ')
+			} else if expr, ok := hints[clause.HintStatement]; ok {
+				expr.Build(stmt)
+				stmt.WriteByte(' ')
+			}
+
+			switch name {
+			case "SELECT", "INSERT", "UPDATE", "DELETE":
+				// clauses without name write the keyword by themselves, e.g: DELETE
+				if expr, ok := hints[clause.HintKeyword]; ok && !keywordHinted {
+					if c.Name != "" {
+						keywordHinted = true
+						expr.Expression = c.AfterNameExpression
+						c.AfterNameExpression = expr
+					} else if v, ok := c.Expression.(clause.Delete); ok {
+						keywordHinted = true
+						v.KeywordHints = expr
+						c.Expression = v
+					}
+				}
+			}
+
+			if expr, ok := hints[clause.HintTable]; ok {
+				switch v := c.Expression.(type) {
+				case clause.From:
+					v.TableHints = expr
+					c.Expression = v
+				case clause.Update:
+					v.TableHints = expr
+					c.Expression = v
+				}
 			}
 
 			firstClauseWritten = true
@@ -507,8 +550,106 @@ func (stmt *Statement) Build(clauses ...string) {
 			}
 		}
 	}
+
 }
 
+// buildHints groups the hints of the statement by their positions, the hints are built with the `HINT` clause builder
+// of the dialector when writing the positions, the hints skipped by the builder or all hints if the dialector doesn't
+// support hints are dropped
+func (stmt *Statement) buildHints() map[clause.HintPosition]hintsExpression {
+	c, ok := stmt.Clauses["HINT"]
+	if !ok {
+		return nil
+	}
+
+	hints, _ := c.Expression.(clause.Hints)
+	builder, ok := stmt.DB.ClauseBuilders["HINT"]
+	if !ok || len(hints.Hints) == 0 {
+		return nil
+	}
+
+	results := map[clause.HintPosition]hintsExpression{}
+	for _, hint := range hints.Hints {
+		// build into a detached statement to find out the hints skipped by the builder, the vars are added when
+		// building the hints into the statement
+		hintStmt := &Statement{DB: stmt.DB, Table: stmt.Table, Schema: stmt.Schema, Context: stmt.Context, BuildClauses: stmt.BuildClauses}
+		builder(clause.Clause{Name: "HINT", Expression: hint}, hintStmt)
+		if hintStmt.SQL.Len() > 0 {
+			expr := results[hint.Position]
+			expr.Builder = builder
+			expr.Hints = append(expr.Hints, hint)
+			results[hint.Position] = expr
+		}
+	}
+	return results
+}
+
+// hintsExpression writes the hints with the clause builder before the expression
+type hintsExpression struct {
+	Hints      []clause.Hint
+	Builder    clause.ClauseBuilder
+	Expression clause.Expression
+}
+
+func (expr hintsExpression) Build(builder clause.Builder) {
+	for idx, hint := range expr.Hints {
+		if idx > 0 {
+			builder.WriteByte(' ')
+		}
+		expr.Builder(clause.Clause{Name: "HINT", Expression: hint}, builder)
+	}
+
+	if expr.Expression != nil {
+		builder.WriteByte(' ')
+		expr.Expression.Build(builder)
+	}
+}
+
+// BuildComment appends the comment clause and the tags extracted from the context to the built statement, it is
+// called by the callbacks after building the whole statement, so the fragments built with Build are not commented.
+// The tags are not sent with prepared statements as they are different per request and the statements are prepared
+// and cached by the SQL, a warning is logged once instead
+func (stmt *Statement) BuildComment() {
+	if stmt.SQL.Len() == 0 || stmt.subquery || utils.Contains(stmt.BuildClauses, "COMMENT") {
+		return
+	}
+
+	comment, _ := stmt.Clauses["COMMENT"].Expression.(clause.Comment)
+	if len(stmt.DB.CommentTags) > 0 {
+		tags := make(map[string]string, len(stmt.DB.CommentTags)+len(comment.Tags))
+		for key, extract := range stmt.DB.CommentTags {
+			if value := extract(stmt.Context); value != "" {
+				tags[key] = value
+			}
+		}
+
+		for key, value := range comment.Tags {
+			if value != "" {
+				tags[key] = value
+			}
+		}
+		comment.Tags = tags
+	}
+
+	if len(comment.Tags) > 0 {
+		switch stmt.ConnPool.(type) {
+		case *PreparedStmtDB, *PreparedStmtTX:
+			comment.Tags = nil
+			preparedCommentTagsOnce.Do(func() {
+				stmt.DB.Logger.Warn(stmt.Context, "comment tags are not sent with prepared statements")
+			})
+		}
+	}
+
+	if comment.Text != "" || len(comment.Tags) > 0 {
+		stmt.WriteByte(' ')
+		comment.Build(stmt)
+	}
+}
+
+// preparedCommentTagsOnce warns the comment tags are not sent with prepared statements once
+var preparedCommentTagsOnce sync.Once
+
 func (stmt *Statement) Parse(value interface{}) (err error) {
 	return stmt.ParseWithSpecialTableName(value, "")
 }
@@ -0,0 +1,82 @@
+package tests_test
+
+import (
+	"context"
+	"testing"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	. "gorm.io/gorm/utils/tests"
+)
+
+func TestCaseExpression(t *testing.T) {
+	users := []User{
+		*GetUser("case_1", Config{}),
+		*GetUser("case_2", Config{}),
+		*GetUser("case_3", Config{}),
+	}
+	users[0].Age, users[1].Age, users[2].Age = 10, 30, 70
+	DB.Create(&users)
+
+	level := clause.Case{
+		Whens: []clause.When{
+			{Condition: clause.Lt{Column: "age", Value: 18}, Then: "junior"},
+			{Condition: clause.Lt{Column: "age", Value: 60}, Then: "adult"},
+		},
+		Else: "senior",
+	}
+
+	type result struct {
+		Name  string
+		Level string
+	}
+
+	var results []result
+	if err := DB.Model(&User{}).Select("name, ? AS level", level).Where("name LIKE ?", "case_%").
+		Order(clause.Case{Whens: []clause.When{{Condition: clause.Eq{Column: "name", Value: "case_2"}, Then: 0}}, Else: 1}).
+		Order("name").Find(&results).Error; err != nil {
+		t.Fatalf("failed to query with case expression, got error: %v", err)
+	}
+
+	if len(results) != 3 || results[0].Name != "case_2" || results[0].Level != "adult" || results[1].Level != "junior" || results[2].Level != "senior" {
+		t.Errorf("invalid results with case expression, got %+v", results)
+	}
+
+	if err := DB.Model(&User{}).Where("name LIKE ?", "case_%").Updates(map[string]interface{}{
+		"active": clause.Case{Whens: []clause.When{{Condition: clause.IN{Column: clause.Column{Name: "name"}, Values: []interface{}{"case_1", "case_3"}}, Then: true}}, Else: false},
+	}).Error; err != nil {
+		t.Fatalf("failed to update with case expression, got error: %v", err)
+	}
+
+	var count int64
+	DB.Model(&User{}).Where("name LIKE ? AND active = ?", "case_%", true).Count(&count)
+	if count != 2 {
+		t.Errorf("invalid updated count with case expression, got %v", count)
+	}
+
+	if _, err := gorm.G[User](DB).Where("name LIKE ?", "case_%").Set(clause.Assignment{
+		Column: clause.Column{Name: "age"},
+		Value:  clause.Case{Whens: []clause.When{{Condition: clause.Gt{Column: "age", Value: 60}, Then: 60}}, Else: clause.Column{Name: "age"}},
+	}).Update(context.Background()); err != nil {
+		t.Fatalf("failed to update with case expression using generics, got error: %v", err)
+	}
+
+	var senior, adult User
+	if DB.First(&senior, "name = ?", "case_3"); senior.Age != 60 {
+		t.Errorf("invalid updated age with case expression, got %v", senior.Age)
+	}
+
+	if DB.First(&adult, "name = ?", "case_2"); adult.Age != 30 {
+		t.Errorf("age should not be changed by case expression, got %v", adult.Age)
+	}
+}
+
+func TestCaseExpressionToSQL(t *testing.T) {
+	sql := DB.ToSQL(func(tx *gorm.DB) *gorm.DB {
+		return tx.Model(&User{}).Where("id = ?", 1).Update("name", clause.Case{
+			Whens: []clause.When{{Condition: clause.Eq{Column: "active", Value: true}, Then: "active"}},
+			Else:  clause.Column{Name: "name"},
+		})
+	})
+	assertEqualSQL(t, `UPDATE "users" SET "name"=CASE WHEN "active" = true THEN 'active' ELSE "name" END,"updated_at"=? WHERE id = 1 AND "users"."deleted_at" IS NULL`, sql)
+}
@@ -0,0 +1,113 @@
+package tests_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	. "gorm.io/gorm/utils/tests"
+)
+
+type requestIDKey struct{}
+
+func TestCommentClause(t *testing.T) {
+	sql := DB.ToSQL(func(tx *gorm.DB) *gorm.DB {
+		return tx.Clauses(clause.Comment{Tags: map[string]string{"route": "/users/:id", "action": "show"}}).
+			Where("id IN (?)", tx.Session(&gorm.Session{NewDB: true}).Model(&User{}).Select("id").Where("age > ?", 18)).
+			Find(&[]User{})
+	})
+
+	if !strings.HasSuffix(sql, " /*action='show',route='%2Fusers%2F%3Aid'*/") || strings.Count(sql, "/*") != 1 {
+		t.Errorf("comment should be appended to the statement only once, got %v", sql)
+	}
+
+	sql = DB.ToSQL(func(tx *gorm.DB) *gorm.DB {
+		return tx.Clauses(clause.Comment{Text: "bulk update"}).Model(&User{}).Where("age > ?", 18).Update("active", true)
+	})
+
+	if !strings.HasSuffix(sql, " /* bulk update */") {
+		t.Errorf("comment should be appended to the update statement, got %v", sql)
+	}
+
+	// the fragments of the statement are built without the comment
+	tx := DB.Clauses(clause.Comment{Text: "fragment"}).Where("name = ?", "comment")
+	tx.Statement.Build("WHERE")
+	if fragment := tx.Statement.SQL.String(); !strings.HasPrefix(fragment, "WHERE") || strings.Contains(fragment, "/*") {
+		t.Errorf("comment should not be appended to the fragment, got %v", fragment)
+	}
+}
+
+func TestCommentTags(t *testing.T) {
+	db, err := OpenTestConnection(&gorm.Config{
+		CommentTags: map[string]func(ctx context.Context) string{
+			"request_id": func(ctx context.Context) string {
+				value, _ := ctx.Value(requestIDKey{}).(string)
+				return value
+			},
+			"application": func(ctx context.Context) string { return "gorm" },
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to connect database, got error %v", err)
+	}
+
+	ctx := context.WithValue(context.Background(), requestIDKey{}, "it's 1")
+	sql := db.WithContext(ctx).ToSQL(func(tx *gorm.DB) *gorm.DB {
+		return tx.Clauses(clause.Comment{Tags: map[string]string{"route": "/users"}}).Where("name = ?", "comment").Find(&[]User{})
+	})
+
+	if !strings.HasSuffix(sql, " /*application='gorm',request_id='it%27s%201',route='%2Fusers'*/") {
+		t.Errorf("comment tags should be appended to the statement, got %v", sql)
+	}
+
+	sql = db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("name = ?", "comment").Find(&[]User{})
+	})
+
+	if !strings.HasSuffix(sql, " /*application='gorm'*/") {
+		t.Errorf("blank tags should be skipped, got %v", sql)
+	}
+
+	user := User{Name: "comment_tags"}
+	if err := db.WithContext(ctx).Create(&user).Error; err != nil {
+		t.Fatalf("failed to create user with comment tags, got error %v", err)
+	}
+
+	tx := db.Session(&gorm.Session{PrepareStmt: true})
+	sql = tx.WithContext(ctx).ToSQL(func(tx *gorm.DB) *gorm.DB {
+		return tx.Clauses(clause.Comment{Text: "prepared", Tags: map[string]string{"route": "/users"}}).Where("name = ?", "comment").Find(&[]User{})
+	})
+
+	if !strings.HasSuffix(sql, " /* prepared */") {
+		t.Errorf("comment tags are not supported in prepared statements, got %v", sql)
+	}
+
+	for _, id := range []string{"1", "2", "3"} {
+		var result User
+		if err := tx.WithContext(context.WithValue(ctx, requestIDKey{}, id)).Where("name = ?", user.Name).First(&result).Error; err != nil || result.ID != user.ID {
+			t.Fatalf("failed to query with comment tags in prepared statements, got error %v", err)
+		}
+	}
+
+	conn, ok := tx.ConnPool.(*gorm.PreparedStmtDB)
+	if !ok {
+		t.Fatalf("should be prepared statement db")
+	}
+
+	var matched int
+	for _, key := range conn.Stmts.Keys() {
+		if strings.Contains(key, "/*") {
+			t.Errorf("comment tags should not be sent in prepared statements, got %v", key)
+		}
+
+		if strings.Contains(key, "name = ?") || strings.Contains(key, "name = $1") {
+			matched++
+		}
+	}
+
+	if matched != 1 {
+		t.Errorf("should cache one prepared statement for the queries, got %v", matched)
+	}
+}
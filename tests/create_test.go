@@ -1,9 +1,11 @@
 package tests_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"regexp"
+	"sort"
 	"testing"
 	"time"
 
@@ -850,3 +852,65 @@ func TestCreateInBatchesWithReturning(t *testing.T) {
 		t.Errorf("expected %d records in DB, got %d", len(users), count)
 	}
 }
+
+func TestCreateFrom(t *testing.T) {
+	users := []User{*GetUser("insert_select_1", Config{}), *GetUser("insert_select_2", Config{})}
+	users[0].Age, users[1].Age = 10, 20
+	users[0].CreatedAt, users[0].UpdatedAt = time.Now().Add(-24*time.Hour), time.Now().Add(-24*time.Hour)
+	DB.Create(&users)
+
+	subquery := DB.Model(&User{}).Where("name = ?", "insert_select_1")
+	if res := DB.Model(&User{}).CreateFrom(subquery); res.Error != nil || res.RowsAffected != 1 {
+		t.Fatalf("failed to create from subquery, affected %v, got error: %v", res.RowsAffected, res.Error)
+	}
+
+	if _, ok := subquery.Statement.Clauses["SELECT"]; ok || len(subquery.Statement.Selects) != 0 {
+		t.Errorf("the subquery shouldn't be changed, got selects %v", subquery.Statement.Selects)
+	}
+
+	var copied []User
+	DB.Where("name = ?", "insert_select_1").Order("id").Find(&copied)
+	if len(copied) != 2 || copied[1].ID == copied[0].ID || copied[1].Age != 10 || !copied[1].Birthday.Equal(*copied[0].Birthday) {
+		t.Errorf("invalid copied users, got %+v", copied)
+	} else if time.Since(copied[1].CreatedAt) > time.Hour || time.Since(copied[1].UpdatedAt) > time.Hour {
+		t.Errorf("the tracking time of copied users should be the current time, got %v, %v", copied[1].CreatedAt, copied[1].UpdatedAt)
+	}
+
+	var created []User
+	if res := DB.Model(&created).Clauses(clause.Returning{}).Select("Name", "Age").
+		CreateFrom(DB.Table("users").Select("name || ?, age + 1", "_copy").Where("name LIKE ? AND deleted_at IS NULL", "insert_select_%")); res.Error != nil || res.RowsAffected != 3 {
+		t.Fatalf("failed to create from subquery with select, affected %v, got error: %v", res.RowsAffected, res.Error)
+	}
+
+	sort.Slice(created, func(i, j int) bool { return created[i].Name < created[j].Name })
+	if len(created) != 3 || created[0].ID == 0 || created[0].Name != "insert_select_1_copy" || created[2].Name != "insert_select_2_copy" || created[2].Age != 21 {
+		t.Errorf("invalid returning users, got %+v", created)
+	}
+
+	if err := DB.Model(&User{}).Select("Name", "Unknown").CreateFrom(DB.Model(&User{})).Error; !errors.Is(err, gorm.ErrInvalidField) {
+		t.Errorf("should returns error for unknown field, got %v", err)
+	}
+
+	languages := []Language{{Code: "insert_select_1", Name: "one"}, {Code: "insert_select_2", Name: "two"}}
+	DB.Create(&languages)
+
+	if res := DB.Clauses(clause.OnConflict{DoNothing: true}).Model(&Language{}).CreateFrom(DB.Model(&Language{}).Where("code LIKE ?", "insert_select_%")); res.Error != nil || res.RowsAffected != 0 {
+		t.Errorf("failed to create from subquery with on conflict do nothing, affected %v, got error: %v", res.RowsAffected, res.Error)
+	}
+
+	if err := DB.Clauses(clause.OnConflict{UpdateAll: true}).Model(&Language{}).Select("Code", "Name").
+		CreateFrom(DB.Table("languages").Select("code, name || ?", "_updated").Where("code LIKE ?", "insert_select_%")).Error; err != nil {
+		t.Fatalf("failed to create from subquery with on conflict update all, got error: %v", err)
+	}
+
+	var updated []Language
+	DB.Where("code LIKE ?", "insert_select_%").Order("code").Find(&updated)
+	if len(updated) != 2 || updated[0].Name != "one_updated" || updated[1].Name != "two_updated" {
+		t.Errorf("invalid languages updated on conflict, got %+v", updated)
+	}
+
+	if rows, err := gorm.G[Language](DB).Select("Code", "Name").CreateFrom(context.Background(), DB.Table("languages").
+		Select("code || ?, name", "_generics").Where("code LIKE ?", "insert_select_%")); err != nil || rows != 2 {
+		t.Errorf("failed to create from subquery using generics, affected %v, got error: %v", rows, err)
+	}
+}
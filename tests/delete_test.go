@@ -2,9 +2,11 @@ package tests_test
 
 import (
 	"errors"
+	"regexp"
 	"testing"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/callbacks"
 	"gorm.io/gorm/clause"
 	. "gorm.io/gorm/utils/tests"
 )
@@ -256,3 +258,89 @@ func TestDeleteReturning(t *testing.T) {
 		t.Errorf("failed to delete data, current count %v", count)
 	}
 }
+
+func TestDeleteWithJoins(t *testing.T) {
+	users := []User{*GetUser("delete-joins-1", Config{}), *GetUser("delete-joins-2", Config{}), *GetUser("delete-joins-3", Config{})}
+	for idx := range users {
+		users[idx].Company = Company{Name: users[idx].Name}
+	}
+
+	if err := DB.Create(&users).Error; err != nil {
+		t.Fatalf("errors happened when create: %v", err)
+	}
+
+	if result := DB.Joins("Company").Where("Company.name = ?", "delete-joins-1").Delete(&User{}); result.Error != nil || result.RowsAffected != 1 {
+		t.Errorf("should only delete one record, but got %v, error: %v", result.RowsAffected, result.Error)
+	}
+
+	if err := DB.First(&User{}, users[0].ID).Error; !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("user should be soft deleted, but got %v", err)
+	}
+
+	if err := DB.Unscoped().First(&User{}, users[0].ID).Error; err != nil {
+		t.Errorf("user should be found with unscoped, but got %v", err)
+	}
+
+	if result := DB.Unscoped().Joins("JOIN companies ON companies.id = users.company_id AND companies.name IN ?", []string{"delete-joins-1", "delete-joins-2"}).Delete(&User{}); result.Error != nil || result.RowsAffected != 2 {
+		t.Errorf("should delete two records, but got %v, error: %v", result.RowsAffected, result.Error)
+	}
+
+	var count int64
+	if DB.Unscoped().Model(&User{}).Where("name LIKE ?", "delete-joins-%").Count(&count); count != 1 {
+		t.Errorf("should only one record left, but got %v", count)
+	}
+}
+
+func TestDeleteWithJoinsToSQL(t *testing.T) {
+	db, _ := gorm.Open(DummyDialector{}, nil)
+
+	sql := db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+		return tx.Unscoped().InnerJoins("Company").Where("Company.name = ?", "jinzhu").Delete(&User{})
+	})
+	assertEqualSQL(t, "DELETE FROM `users` WHERE `users`.`id` IN (SELECT * FROM (SELECT `users`.`id` FROM `users` INNER JOIN `companies` `Company` ON `users`.`company_id` = `Company`.`id` WHERE Company.name = \"jinzhu\") AS `joined_users`)", sql)
+
+	sql = db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+		return tx.Joins("Company").Where("Company.name = ?", "jinzhu").Delete(&User{})
+	})
+	if !regexp.MustCompile("UPDATE `users` SET `deleted_at`=.* WHERE `users`.`id` IN \\(SELECT \\* FROM \\(SELECT `users`.`id` FROM `users` LEFT JOIN `companies` `Company` ON `users`.`company_id` = `Company`.`id` WHERE Company.name = \"jinzhu\"\\) AS `joined_users`\\) AND `users`.`deleted_at` IS NULL").MatchString(sql) {
+		t.Errorf("invalid soft delete sql with joins, got %v", sql)
+	}
+
+	sql = db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+		return tx.Unscoped().Clauses(clause.Using{Tables: []clause.Table{{Name: "blacklists"}}}).Where("blacklists.email = users.name").Delete(&User{})
+	})
+	assertEqualSQL(t, "DELETE FROM `users` WHERE blacklists.email = users.name", sql)
+
+	db, _ = gorm.Open(joinStyleDialector{style: callbacks.FromJoinStyle}, nil)
+	sql = db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+		return tx.Unscoped().InnerJoins("Company").Where("Company.name = ?", "jinzhu").Delete(&User{})
+	})
+	assertEqualSQL(t, "DELETE FROM `users` USING `companies` `Company` WHERE Company.name = \"jinzhu\" AND `users`.`company_id` = `Company`.`id`", sql)
+
+	sql = db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+		return tx.Unscoped().Clauses(clause.Using{Tables: []clause.Table{{Name: "blacklists"}}}).Where("blacklists.email = users.name").Delete(&User{})
+	})
+	assertEqualSQL(t, "DELETE FROM `users` USING `blacklists` WHERE blacklists.email = users.name", sql)
+
+	db, _ = gorm.Open(joinStyleDialector{style: callbacks.MultiTableJoinStyle}, nil)
+	sql = db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+		return tx.Unscoped().Joins("Company").Where("Company.name = ?", "jinzhu").Delete(&User{})
+	})
+	assertEqualSQL(t, "DELETE `users` FROM `users` LEFT JOIN `companies` `Company` ON `users`.`company_id` = `Company`.`id` WHERE Company.name = \"jinzhu\"", sql)
+}
+
+func TestDeleteWithJoinsWithoutConditions(t *testing.T) {
+	for _, tx := range []*gorm.DB{
+		DB.Joins("LEFT JOIN companies ON companies.id = users.company_id"),
+		DB.Unscoped().Joins("LEFT JOIN companies ON companies.id = users.company_id"),
+		DB.Unscoped().Joins("CROSS JOIN companies"),
+	} {
+		if err := tx.Delete(&User{}).Error; !errors.Is(err, gorm.ErrMissingWhereClause) {
+			t.Errorf("should returns missing where clause error when deleting with joins not restricting rows, got %v", err)
+		}
+	}
+
+	if err := DB.Session(&gorm.Session{DryRun: true}).Unscoped().Joins("JOIN companies ON companies.id = users.company_id").Delete(&User{}).Error; err != nil {
+		t.Errorf("inner join with conditions should restrict deleted rows, got error %v", err)
+	}
+}
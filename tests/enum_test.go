@@ -0,0 +1,253 @@
+package tests_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+)
+
+type EnumArticle struct {
+	ID       uint
+	Title    string
+	Status   string  `gorm:"enum:draft,published,archived;default:draft"`
+	Priority int     `gorm:"enum:1,2,3"`
+	Kind     *string `gorm:"enum:news,blog"`
+}
+
+func TestEnumField(t *testing.T) {
+	DB.Migrator().DropTable(&EnumArticle{})
+	if err := DB.AutoMigrate(&EnumArticle{}); err != nil {
+		t.Fatalf("failed to migrate enum field, got error %v", err)
+	}
+
+	if !DB.Migrator().HasConstraint(&EnumArticle{}, "chk_enum_articles_status_enum") {
+		t.Errorf("should create check constraint for enum field")
+	}
+
+	article := EnumArticle{Title: "enum", Priority: 1}
+	if err := DB.Create(&article).Error; err != nil {
+		t.Fatalf("failed to create enum article, got error %v", err)
+	}
+
+	var result EnumArticle
+	if err := DB.First(&result, article.ID).Error; err != nil || result.Status != "draft" {
+		t.Fatalf("enum field should use default value, got %v, error %v", result.Status, err)
+	}
+
+	kind := "poem"
+	for _, invalid := range []EnumArticle{{Status: "deleted", Priority: 1}, {Status: "draft", Priority: 4}, {Status: "draft", Priority: 1, Kind: &kind}} {
+		if err := DB.Create(&invalid).Error; !errors.Is(err, gorm.ErrInvalidEnumValue) {
+			t.Errorf("should returns invalid enum value error when creating %+v, got %v", invalid, err)
+		}
+	}
+
+	if err := DB.Create([]EnumArticle{{Status: "published", Priority: 2}, {Status: "deleted", Priority: 2}}).Error; !errors.Is(err, gorm.ErrInvalidEnumValue) {
+		t.Errorf("should returns invalid enum value error when batch creating, got %v", err)
+	}
+
+	if err := DB.Model(&result).Update("status", "deleted").Error; !errors.Is(err, gorm.ErrInvalidEnumValue) {
+		t.Errorf("should returns invalid enum value error when updating, got %v", err)
+	}
+
+	if err := DB.Model(&result).Updates(map[string]interface{}{"priority": 5}).Error; !errors.Is(err, gorm.ErrInvalidEnumValue) {
+		t.Errorf("should returns invalid enum value error when updating with map, got %v", err)
+	}
+
+	if err := DB.Model(&result).Clauses(clause.Set{{Column: clause.Column{Name: "status"}, Value: "deleted"}}).Updates(map[string]interface{}{}).Error; !errors.Is(err, gorm.ErrInvalidEnumValue) {
+		t.Errorf("should returns invalid enum value error when updating with preset assignments, got %v", err)
+	}
+
+	if _, err := gorm.G[EnumArticle](DB).Where("id = ?", article.ID).Set(clause.Assignment{Column: clause.Column{Name: "priority"}, Value: 4}).Update(context.Background()); !errors.Is(err, gorm.ErrInvalidEnumValue) {
+		t.Errorf("should returns invalid enum value error when updating with generics set, got %v", err)
+	}
+
+	if err := DB.Model(&result).Updates(EnumArticle{Status: "published", Priority: 3}).Error; err != nil {
+		t.Errorf("failed to update enum field, got error %v", err)
+	}
+
+	if err := DB.First(&result, article.ID).Error; err != nil || result.Status != "published" || result.Priority != 3 {
+		t.Errorf("failed to update enum field, got %+v, error %v", result, err)
+	}
+
+	if err := DB.UpdatesInBatches(&[]EnumArticle{{ID: article.ID, Status: "archived", Priority: 2}, {ID: article.ID, Status: "deleted", Priority: 2}}, 10).Error; !errors.Is(err, gorm.ErrInvalidEnumValue) {
+		t.Errorf("should returns invalid enum value error when updating in batches, got %v", err)
+	}
+
+	if err := DB.First(&result, article.ID).Error; err != nil || result.Status != "published" {
+		t.Errorf("enum field shouldn't be updated with invalid values in batches, got %+v, error %v", result, err)
+	}
+
+	if err := DB.Exec("UPDATE enum_articles SET status = ? WHERE id = ?", "deleted", article.ID).Error; err == nil {
+		t.Errorf("check constraint should reject invalid enum value")
+	}
+}
+
+type nativeEnumDialector struct {
+	gorm.Dialector
+}
+
+func (nativeEnumDialector) EnumDataTypeOf(field *schema.Field) string {
+	if field.GORMDataType != schema.String {
+		return ""
+	}
+	return "ENUM('" + strings.Join(field.EnumValues, "','") + "')"
+}
+
+func TestEnumFieldWithNativeType(t *testing.T) {
+	var statements []string
+	tx := DB.Session(&gorm.Session{DryRun: true, Logger: Tracer{
+		Logger: DB.Config.Logger,
+		Test: func(ctx context.Context, begin time.Time, fc func() (sql string, rowsAffected int64), err error) {
+			sql, _ := fc()
+			statements = append(statements, sql)
+		},
+	}})
+	tx.Dialector = nativeEnumDialector{Dialector: DB.Dialector}
+
+	if err := tx.Migrator().CreateTable(&EnumArticle{}); err != nil {
+		t.Fatalf("failed to create table, got error %v", err)
+	}
+
+	if len(statements) != 1 {
+		t.Fatalf("expects one statement, got %v", statements)
+	}
+
+	if !strings.Contains(statements[0], "ENUM('draft','published','archived')") || !strings.Contains(statements[0], "ENUM('news','blog')") {
+		t.Errorf("should use native enum type, got %v", statements[0])
+	}
+
+	if strings.Contains(statements[0], "status IN") || !strings.Contains(statements[0], "priority IN (1,2,3)") {
+		t.Errorf("should only create check constraint for the enum fields without native type, got %v", statements[0])
+	}
+}
+
+// enumTypeDialector creates the named enum types like Postgres
+type enumTypeDialector struct {
+	gorm.Dialector
+	migrated *[]string
+}
+
+func (enumTypeDialector) EnumDataTypeOf(field *schema.Field) string {
+	return field.Schema.Table + "_" + field.DBName
+}
+
+func (d enumTypeDialector) Migrator(db *gorm.DB) gorm.Migrator {
+	return enumTypeMigrator{Migrator: d.Dialector.Migrator(db), migrated: d.migrated}
+}
+
+type enumTypeMigrator struct {
+	gorm.Migrator
+	migrated *[]string
+}
+
+func (m enumTypeMigrator) MigrateEnumType(value interface{}, field *schema.Field) error {
+	*m.migrated = append(*m.migrated, field.Schema.Table+"_"+field.DBName+" AS ENUM ('"+strings.Join(field.EnumValues, "','")+"')")
+	return nil
+}
+
+func TestEnumFieldWithNamedType(t *testing.T) {
+	var statements, migrated []string
+	tx := DB.Session(&gorm.Session{DryRun: true, Logger: Tracer{
+		Logger: DB.Config.Logger,
+		Test: func(ctx context.Context, begin time.Time, fc func() (sql string, rowsAffected int64), err error) {
+			sql, _ := fc()
+			statements = append(statements, sql)
+		},
+	}})
+	tx.Dialector = enumTypeDialector{Dialector: DB.Dialector, migrated: &migrated}
+
+	if err := tx.Migrator().CreateTable(&EnumArticle{}); err != nil {
+		t.Fatalf("failed to create table, got error %v", err)
+	}
+
+	if len(migrated) != 3 || migrated[0] != "enum_articles_status AS ENUM ('draft','published','archived')" ||
+		migrated[1] != "enum_articles_priority AS ENUM ('1','2','3')" || migrated[2] != "enum_articles_kind AS ENUM ('news','blog')" {
+		t.Errorf("should migrate the enum types of the enum fields, got %v", migrated)
+	}
+
+	if len(statements) != 1 || !strings.Contains(statements[0], "enum_articles_status DEFAULT") || strings.Contains(statements[0], " IN (") {
+		t.Errorf("should use the enum types instead of check constraints, got %v", statements)
+	}
+}
+
+func TestMigrateEnumValues(t *testing.T) {
+	type EnumPost struct {
+		ID       uint
+		Status   string `gorm:"enum:draft,published"`
+		Priority int    `gorm:"enum:1,2"`
+	}
+
+	DB.Migrator().DropTable(&EnumPost{})
+	if err := DB.AutoMigrate(&EnumPost{}); err != nil {
+		t.Fatalf("failed to migrate enum field, got error %v", err)
+	}
+
+	if err := DB.Exec("INSERT INTO enum_posts (status, priority) VALUES (?, ?)", "archived", 3).Error; err == nil {
+		t.Fatalf("check constraint should reject the enum values not added")
+	}
+
+	// the enum check constraints are recreated if the migrator of the dialector reads their definitions
+	db := DB
+	if _, ok := DB.Migrator().(gorm.CheckConstraintMigrator); !ok {
+		if DB.Dialector.Name() != "sqlite" {
+			t.Skip("the migrator of the dialector doesn't read the definition of check constraints")
+		}
+
+		sqlDB, _ := DB.DB()
+		var err error
+		if db, err = gorm.Open(checkConstraintsDialector{Dialector: &sqlite.Dialector{Conn: sqlDB}, table: "enum_posts"}, &gorm.Config{}); err != nil {
+			t.Fatalf("failed to open db, got error %v", err)
+		}
+	}
+
+	type EnumPostV2 struct {
+		ID       uint
+		Status   string `gorm:"enum:draft,published,archived"`
+		Priority int    `gorm:"enum:1,2,3"`
+	}
+
+	if err := db.Table("enum_posts").AutoMigrate(&EnumPostV2{}); err != nil {
+		t.Fatalf("failed to migrate added enum values, got error %v", err)
+	}
+
+	if err := DB.Exec("INSERT INTO enum_posts (status, priority) VALUES (?, ?)", "archived", 3).Error; err != nil {
+		t.Errorf("added enum values should be migrated, got error %v", err)
+	}
+}
+
+// checkConstraintsDialector reads the definition of check constraints from the table definition of sqlite, like the
+// dialectors implementing gorm.CheckConstraintMigrator
+type checkConstraintsDialector struct {
+	gorm.Dialector
+	table string
+}
+
+func (d checkConstraintsDialector) Migrator(db *gorm.DB) gorm.Migrator {
+	return checkConstraintsMigrator{Migrator: d.Dialector.Migrator(db), db: db, table: d.table}
+}
+
+type checkConstraintsMigrator struct {
+	gorm.Migrator
+	db    *gorm.DB
+	table string
+}
+
+func (m checkConstraintsMigrator) CheckConstraintDefinition(value interface{}, name string) (string, error) {
+	var ddl string
+	if err := m.db.Session(&gorm.Session{NewDB: true}).Raw("SELECT sql FROM sqlite_master WHERE type = ? AND tbl_name = ?", "table", m.table).Scan(&ddl).Error; err != nil {
+		return "", err
+	}
+
+	if matches := regexp.MustCompile("CONSTRAINT `" + name + "` CHECK \\((.+?\\))\\)").FindStringSubmatch(ddl); len(matches) == 2 {
+		return matches[1], nil
+	}
+	return "", nil
+}
@@ -1,9 +1,11 @@
 package tests_test
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"log"
 	"reflect"
 	"regexp"
 	"sort"
@@ -16,6 +18,7 @@ import (
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
+	"gorm.io/gorm/logger"
 	. "gorm.io/gorm/utils/tests"
 )
 
@@ -323,6 +326,315 @@ func TestGenericsFindInBatches(t *testing.T) {
 	}
 }
 
+func TestGenericsEach(t *testing.T) {
+	ctx := context.Background()
+
+	users := []User{{Name: "GenericsEach1", Age: 1}, {Name: "GenericsEach2", Age: 2}, {Name: "GenericsEach3", Age: 3}}
+	if err := gorm.G[User](DB).CreateInBatches(ctx, &users, len(users)); err != nil {
+		t.Fatalf("CreateInBatches failed: %v", err)
+	}
+
+	var results []User
+	for user, err := range gorm.G[User](DB).Where("name like ?", "GenericsEach%").Order("id").Each(ctx) {
+		if err != nil {
+			t.Fatalf("Each failed: %v", err)
+		}
+		results = append(results, user)
+	}
+
+	if len(results) != len(users) {
+		t.Fatalf("expected %d records, got %d", len(users), len(results))
+	}
+
+	for idx, result := range results {
+		AssertObjEqual(t, result, users[idx], "ID", "Name", "Age")
+	}
+
+	count := 0
+	for _, err := range gorm.G[User](DB).Where("name like ?", "GenericsEach%").Each(ctx) {
+		if err != nil {
+			t.Fatalf("Each failed: %v", err)
+		}
+
+		if count++; count == 1 {
+			break
+		}
+	}
+
+	if count != 1 {
+		t.Errorf("expected to stop after breaking the loop, got %d", count)
+	}
+}
+
+func TestGenericsAggregates(t *testing.T) {
+	ctx := context.Background()
+
+	users := []User{{Name: "GenericsAggregate1", Age: 10}, {Name: "GenericsAggregate2", Age: 20}, {Name: "GenericsAggregate3", Age: 30}}
+	if err := gorm.G[User](DB).CreateInBatches(ctx, &users, len(users)); err != nil {
+		t.Fatalf("CreateInBatches failed: %v", err)
+	}
+
+	chain := gorm.G[User](DB).Where("name like ?", "GenericsAggregate%")
+	if sum, err := gorm.Scalar[int64](chain).Sum(ctx, "Age"); err != nil || sum != 60 {
+		t.Errorf("expected sum 60, got %v, error: %v", sum, err)
+	}
+
+	if avg, err := gorm.Scalar[float64](chain).Avg(ctx, "age"); err != nil || avg != 20 {
+		t.Errorf("expected avg 20, got %v, error: %v", avg, err)
+	}
+
+	if min, err := gorm.Scalar[uint](chain).Min(ctx, "age"); err != nil || min != 10 {
+		t.Errorf("expected min 10, got %v, error: %v", min, err)
+	}
+
+	if max, err := gorm.Scalar[uint](chain.Where("age < ?", 30)).Max(ctx, "age"); err != nil || max != 20 {
+		t.Errorf("expected max 20, got %v, error: %v", max, err)
+	}
+
+	if sum, err := gorm.Scalar[int64](gorm.G[User](DB).Where("name = ?", "GenericsAggregateNotFound")).Sum(ctx, "age"); err != nil || sum != 0 {
+		t.Errorf("expected sum 0 for no rows, got %v, error: %v", sum, err)
+	}
+
+	if sum, err := gorm.Scalar[int64](chain).Sum(ctx, gorm.Expr("age * 2")); err != nil || sum != 120 {
+		t.Errorf("expected sum 120 for expression, got %v, error: %v", sum, err)
+	}
+
+	// the expressions should be clause.Expr, the strings are names of fields or columns
+	if _, err := gorm.Scalar[int64](chain).Sum(ctx, "age * 2"); err == nil {
+		t.Errorf("expected error for the expression as column name")
+	}
+
+	if _, err := gorm.Scalar[int64](chain.Group("name")).Sum(ctx, "age"); !errors.Is(err, gorm.ErrInvalidData) {
+		t.Errorf("expected error for sum with group by, got %v", err)
+	}
+
+	if names, err := gorm.Scalar[string](chain.Order("age desc")).Pluck(ctx, "Name"); err != nil || !reflect.DeepEqual(names, []string{"GenericsAggregate3", "GenericsAggregate2", "GenericsAggregate1"}) {
+		t.Errorf("unexpected pluck result %v, error: %v", names, err)
+	}
+
+	if ages, err := gorm.Scalar[uint](chain.Where("age > ?", 15).Order("age")).Pluck(ctx, "age"); err != nil || !reflect.DeepEqual(ages, []uint{20, 30}) {
+		t.Errorf("unexpected pluck result %v, error: %v", ages, err)
+	}
+
+	if exists, err := chain.Exists(ctx); err != nil || !exists {
+		t.Errorf("expected exists, got %v, error: %v", exists, err)
+	}
+
+	if exists, err := gorm.G[User](DB).Where("name = ?", "GenericsAggregateNotFound").Exists(ctx); err != nil || exists {
+		t.Errorf("expected not exists, got %v, error: %v", exists, err)
+	}
+}
+
+func TestGenericsProject(t *testing.T) {
+	ctx := context.Background()
+
+	company := Company{Name: "GenericsProjectCompany"}
+	DB.Create(&company)
+
+	users := []User{
+		{Name: "GenericsProject1", Age: 18, CompanyID: &company.ID},
+		{Name: "GenericsProject2", Age: 20},
+		{Name: "GenericsProject3", Age: 30, CompanyID: &company.ID},
+	}
+	if err := gorm.G[User](DB).CreateInBatches(ctx, &users, len(users)); err != nil {
+		t.Fatalf("CreateInBatches failed: %v", err)
+	}
+	DB.Delete(&users[2])
+
+	type UserDTO struct {
+		ID          uint
+		Name        string
+		Years       uint `gorm:"column:age"`
+		CompanyName string
+		CompanyID   *int
+		Unknown     string
+	}
+
+	chain := gorm.G[User](DB).Joins(clause.LeftJoin.Association("Company"), nil).Where("users.name like ?", "GenericsProject%").Order("users.id")
+	dtos, err := gorm.Project[UserDTO](chain).Find(ctx)
+	if err != nil {
+		t.Fatalf("Find failed: %v", err)
+	}
+
+	if len(dtos) != 2 {
+		t.Fatalf("expected 2 records without soft deleted, got %d", len(dtos))
+	}
+
+	if dtos[0].ID != users[0].ID || dtos[0].Name != users[0].Name || dtos[0].Years != 18 || dtos[0].CompanyName != company.Name ||
+		dtos[0].CompanyID == nil || *dtos[0].CompanyID != company.ID || dtos[0].Unknown != "" {
+		t.Errorf("invalid projected result %+v", dtos[0])
+	}
+
+	if dtos[1].ID != users[1].ID || dtos[1].Years != 20 || dtos[1].CompanyName != "" || dtos[1].CompanyID != nil {
+		t.Errorf("invalid projected result %+v", dtos[1])
+	}
+
+	dto, err := gorm.Project[UserDTO](chain.Where("users.age > ?", 18)).First(ctx)
+	if err != nil || dto.ID != users[1].ID || dto.Name != users[1].Name {
+		t.Errorf("invalid projected result %+v, error: %v", dto, err)
+	}
+
+	// the projected chain is chained further with the joins and conditions
+	projected := gorm.Project[UserDTO](gorm.G[User](DB).Where("users.name like ?", "GenericsProject%"))
+	dtos, err = projected.Joins(clause.LeftJoin.Association("Company"), nil).Where("users.age < ?", 20).Find(ctx)
+	if err != nil || len(dtos) != 1 || dtos[0].ID != users[0].ID || dtos[0].CompanyName != company.Name {
+		t.Errorf("invalid projected result of chained projection %+v, error: %v", dtos, err)
+	}
+
+	if count, err := projected.Count(ctx, "*"); err != nil || count != 2 {
+		t.Errorf("expected 2 records of projection, got %v, error: %v", count, err)
+	}
+
+	var count int
+	for dto, err := range gorm.Project[UserDTO](gorm.G[User](DB).Where("name like ?", "GenericsProject%")).Each(ctx) {
+		if err != nil || dto.Name == "" || dto.CompanyName != "" {
+			t.Errorf("invalid projected result %+v, error: %v", dto, err)
+		}
+		count++
+	}
+
+	if count != 2 {
+		t.Errorf("expected 2 records, got %d", count)
+	}
+
+	if _, err := gorm.Project[UserDTO](chain.Where("users.name = ?", "not found")).Take(ctx); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("expected record not found, got %v", err)
+	}
+
+	if _, err := gorm.Project[UserDTO](customChain{chain}).Where("users.age > ?", 18).Find(ctx); !errors.Is(err, gorm.ErrNotImplemented) {
+		t.Errorf("expected not implemented for custom chain, got %v", err)
+	}
+
+	var name string
+	if err := gorm.Project[UserDTO](customChain{chain}).Row(ctx).Scan(&name); !errors.Is(err, gorm.ErrNotImplemented) {
+		t.Errorf("expected not implemented for row of custom chain, got %v", err)
+	}
+}
+
+type customChain struct {
+	gorm.ChainInterface[User]
+}
+
+func TestGenericsDebug(t *testing.T) {
+	ctx := context.Background()
+
+	var buf bytes.Buffer
+	db := DB.Session(&gorm.Session{Logger: logger.New(log.New(&buf, "", 0), logger.Config{LogLevel: logger.Silent})})
+
+	user := User{Name: "GenericsDebug", Age: 18}
+	if err := gorm.G[User](db).Create(ctx, &user); err != nil || buf.Len() != 0 {
+		t.Fatalf("Create failed or logged without debug: %v, logged: %v", err, buf.String())
+	}
+
+	if err := gorm.G[User](db).Debug().Create(ctx, &User{Name: "GenericsDebug", Age: 20}); err != nil || !strings.Contains(buf.String(), "INSERT INTO") {
+		t.Errorf("expected the created sql logged with debug, got %v, error: %v", buf.String(), err)
+	}
+
+	buf.Reset()
+	if _, err := gorm.G[User](db).Where("name = ?", "GenericsDebug").Debug().Find(ctx); err != nil || !strings.Contains(buf.String(), "GenericsDebug") {
+		t.Errorf("expected the queried sql logged with debug, got %v, error: %v", buf.String(), err)
+	}
+
+	buf.Reset()
+	if _, err := gorm.G[User](db).Where("name = ?", "GenericsDebug").Find(ctx); err != nil || buf.Len() != 0 {
+		t.Errorf("expected nothing logged without debug, got %v, error: %v", buf.String(), err)
+	}
+}
+
+func TestGenericsSave(t *testing.T) {
+	ctx := context.Background()
+
+	user := User{Name: "GenericsSave", Age: 18}
+	if err := gorm.G[User](DB).Save(ctx, &user); err != nil || user.ID == 0 {
+		t.Fatalf("Save failed: %v, id: %v", err, user.ID)
+	}
+
+	user.Age = 20
+	if err := gorm.G[User](DB).Save(ctx, &user); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	if result, err := gorm.G[User](DB).Where("id = ?", user.ID).First(ctx); err != nil || result.Age != 20 {
+		t.Errorf("expected age 20 after saving, got %v, error: %v", result.Age, err)
+	}
+}
+
+func TestGenericsFirstOrInitAndFirstOrCreate(t *testing.T) {
+	ctx := context.Background()
+
+	user, err := gorm.G[User](DB).Where(User{Name: "GenericsFirstOrInit"}).Attrs(User{Age: 18}).FirstOrInit(ctx)
+	if err != nil || user.ID != 0 || user.Name != "GenericsFirstOrInit" || user.Age != 18 {
+		t.Errorf("unexpected FirstOrInit result %+v, error: %v", user, err)
+	}
+
+	user, err = gorm.G[User](DB).Where(User{Name: "GenericsFirstOrCreate"}).Attrs(User{Age: 18}).FirstOrCreate(ctx)
+	if err != nil || user.ID == 0 || user.Age != 18 {
+		t.Fatalf("unexpected FirstOrCreate result %+v, error: %v", user, err)
+	}
+
+	found, err := gorm.G[User](DB).Where(User{Name: "GenericsFirstOrCreate"}).Attrs(User{Age: 30}).FirstOrCreate(ctx)
+	if err != nil || found.ID != user.ID || found.Age != 18 {
+		t.Errorf("should find the existing record, got %+v, error: %v", found, err)
+	}
+
+	found, err = gorm.G[User](DB).Where(User{Name: "GenericsFirstOrCreate"}).Assign(User{Age: 20}).FirstOrCreate(ctx)
+	if err != nil || found.ID != user.ID || found.Age != 20 {
+		t.Errorf("should assign the existing record, got %+v, error: %v", found, err)
+	}
+
+	found, err = gorm.G[User](DB).Where("id = ?", user.ID).First(ctx)
+	if err != nil || found.Age != 20 {
+		t.Errorf("expected age 20 after assigning, got %v, error: %v", found.Age, err)
+	}
+
+	if count, err := gorm.G[User](DB).Where("name = ?", "GenericsFirstOrInit").Count(ctx, "*"); err != nil || count != 0 {
+		t.Errorf("FirstOrInit should not create record, got %v, error: %v", count, err)
+	}
+}
+
+func TestGenericsUnscopedAndClauses(t *testing.T) {
+	ctx := context.Background()
+
+	users := []User{{Name: "GenericsUnscoped1"}, {Name: "GenericsUnscoped2"}}
+	if err := gorm.G[User](DB).CreateInBatches(ctx, &users, len(users)); err != nil {
+		t.Fatalf("CreateInBatches failed: %v", err)
+	}
+
+	if _, err := gorm.G[User](DB).Where("id = ?", users[0].ID).Delete(ctx); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+
+	if result, err := gorm.G[User](DB).Where("name like ?", "GenericsUnscoped%").Find(ctx); err != nil || len(result) != 1 {
+		t.Errorf("expected 1 record, got %v, error: %v", len(result), err)
+	}
+
+	if result, err := gorm.G[User](DB).Unscoped().Where("name like ?", "GenericsUnscoped%").Find(ctx); err != nil || len(result) != 2 {
+		t.Errorf("expected 2 records with unscoped, got %v, error: %v", len(result), err)
+	}
+
+	if _, err := gorm.G[User](DB).Where("id = ?", users[0].ID).Unscoped().Delete(ctx); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+
+	if count, err := gorm.G[User](DB).Unscoped().Where("id = ?", users[0].ID).Count(ctx, "*"); err != nil || count != 0 {
+		t.Errorf("expected record deleted permanently, got %v, error: %v", count, err)
+	}
+
+	user := User{Name: "GenericsClauses", Age: 18}
+	user.ID = users[1].ID
+	if err := gorm.G[User](DB).Clauses(clause.OnConflict{UpdateAll: true}).Create(ctx, &user); err != nil {
+		t.Fatalf("Create with on conflict failed: %v", err)
+	}
+
+	if result, err := gorm.G[User](DB).Where("id = ?", user.ID).Clauses(clause.Locking{Strength: "UPDATE"}).First(ctx); err != nil || result.Name != "GenericsClauses" || result.Age != 18 {
+		t.Errorf("expected record upserted, got %+v, error: %v", result, err)
+	}
+
+	if result, err := gorm.G[User](DB).Unscoped().Clauses(clause.Where{Exprs: []clause.Expression{clause.Eq{Column: "name", Value: "GenericsClauses"}}}).Take(ctx); err != nil || result.ID != user.ID {
+		t.Errorf("expected record found with clauses, got %+v, error: %v", result, err)
+	}
+}
+
 func TestGenericsScopes(t *testing.T) {
 	ctx := context.Background()
 
@@ -1117,3 +1429,57 @@ func TestGenericsAssociationSlice(t *testing.T) {
 		}
 	}
 }
+
+func TestGenericsJoinsSubquery(t *testing.T) {
+	ctx := context.Background()
+	users := []User{*GetUser("generics-joins-subquery-1", Config{Pets: 2}), *GetUser("generics-joins-subquery-2", Config{Pets: 1})}
+	if err := DB.Create(&users).Error; err != nil {
+		t.Fatalf("failed to create users, got error: %v", err)
+	}
+
+	type UserWithPet struct {
+		User
+		LatestPet Pet `gorm:"-"`
+	}
+
+	latestPets := gorm.G[Pet](DB).Where("id IN (?)", DB.Model(&Pet{}).Select("MAX(id)").Group("user_id"))
+	results, err := gorm.G[UserWithPet](DB).Table("users").Joins(clause.InnerJoin.Subquery(latestPets).As("LatestPet"),
+		func(db gorm.JoinBuilder, joinTable clause.Table, curTable clause.Table) error {
+			db.Where("?.user_id = ?.id", joinTable, curTable)
+			return nil
+		},
+	).Where("users.name LIKE ?", "generics-joins-subquery-%").Order("users.id").Find(ctx)
+	if err != nil {
+		t.Fatalf("failed to join subquery, got error: %v", err)
+	}
+
+	if len(results) != 2 || results[0].Name != users[0].Name || results[0].LatestPet.ID != users[0].Pets[1].ID ||
+		results[0].LatestPet.Name != users[0].Pets[1].Name || results[1].LatestPet.Name != users[1].Pets[0].Name {
+		t.Fatalf("invalid results of subquery join, got %+v", results)
+	}
+
+	results, err = gorm.G[UserWithPet](DB).Table("users").Joins(clause.LeftJoin.Subquery(latestPets).As("LatestPet"),
+		func(db gorm.JoinBuilder, joinTable clause.Table, curTable clause.Table) error {
+			db.Select("Name").Where("?.user_id = ?.id", joinTable, curTable)
+			return nil
+		},
+	).Where("users.name = ?", users[1].Name).Find(ctx)
+	if err != nil || len(results) != 1 || results[0].LatestPet.Name != users[1].Pets[0].Name || results[0].LatestPet.ID != 0 {
+		t.Fatalf("invalid results of subquery join with select, got %+v, error: %v", results, err)
+	}
+
+	if _, err := gorm.G[UserWithPet](DB).Table("users").Joins(clause.LeftJoin.Subquery(latestPets), nil).Find(ctx); !errors.Is(err, gorm.ErrInvalidData) {
+		t.Errorf("joining subquery without alias should return error, got %v", err)
+	}
+
+	lateral := gorm.G[UserWithPet](DB).Table("users").Joins(clause.LeftJoin.Lateral(gorm.G[Pet](DB).Where("pets.user_id = users.id").Order("id DESC").Limit(3)).As("LatestPet"),
+		func(db gorm.JoinBuilder, joinTable clause.Table, curTable clause.Table) error {
+			db.Select("ID", "Name")
+			return nil
+		},
+	)
+	sql := DB.ToSQL(func(tx *gorm.DB) *gorm.DB {
+		return tx.Raw("?", lateral).Scan(&[]UserWithPet{})
+	})
+	assertEqualSQL(t, `SELECT "users"."id","users"."created_at","users"."updated_at","users"."deleted_at","users"."name","users"."age","users"."birthday","users"."company_id","users"."manager_id","users"."active","LatestPet"."id" AS "LatestPet__id","LatestPet"."name" AS "LatestPet__name" FROM "users" LEFT JOIN LATERAL (SELECT * FROM "pets" WHERE pets.user_id = users.id AND "pets"."deleted_at" IS NULL ORDER BY id DESC LIMIT 3) AS "LatestPet" ON TRUE WHERE "users"."deleted_at" IS NULL`, sql)
+}
@@ -1,11 +1,14 @@
 package tests_test
 
 import (
+	"errors"
 	"testing"
 
 	"gorm.io/driver/mysql"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+	. "gorm.io/gorm/utils/tests"
 )
 
 func TestOpen(t *testing.T) {
@@ -101,3 +104,28 @@ func TestReturningWithNullToZeroValues(t *testing.T) {
 
 	}
 }
+
+func TestStrictTags(t *testing.T) {
+	type StrictTagsProduct struct {
+		ID   uint
+		Code string `gorm:"uniqe"`
+	}
+
+	db, err := OpenTestConnection(&gorm.Config{StrictTags: true})
+	if err != nil {
+		t.Fatalf("failed to connect database, got error %v", err)
+	}
+
+	if err := db.Migrator().AutoMigrate(&User{}, &Pet{}, &Company{}); err != nil {
+		t.Fatalf("failed to migrate valid models in strict mode, got error %v", err)
+	}
+
+	if err := db.Migrator().AutoMigrate(&StrictTagsProduct{}); !errors.Is(err, schema.ErrInvalidTagSetting) {
+		t.Fatalf("expects invalid tag setting error, got %v", err)
+	}
+
+	if err := DB.Migrator().AutoMigrate(&StrictTagsProduct{}); err != nil {
+		t.Fatalf("should not validate tags without strict mode, got error %v", err)
+	}
+	DB.Migrator().DropTable(&StrictTagsProduct{})
+}
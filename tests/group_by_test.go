@@ -1,8 +1,11 @@
 package tests_test
 
 import (
+	"errors"
 	"testing"
 
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	. "gorm.io/gorm/utils/tests"
 )
 
@@ -107,3 +110,57 @@ func TestGroupBy(t *testing.T) {
 		}
 	}
 }
+
+func TestGroupByRollup(t *testing.T) {
+	rollup := func(tx *gorm.DB) *gorm.DB {
+		return tx.Model(&User{}).Select("name, active, SUM(age) AS total, ? AS subtotal", clause.Grouping{Name: "active"}).
+			Clauses(clause.GroupBy{Rollup: []clause.Column{{Name: "name"}, {Name: "active"}}}).Find(&[]map[string]interface{}{})
+	}
+	groupingSets := func(tx *gorm.DB) *gorm.DB {
+		return tx.Model(&User{}).Select("name, active, SUM(age) AS total").Group("name").
+			Clauses(clause.GroupBy{GroupingSets: [][]clause.Column{{{Name: "active"}}, {}}}).Find(&[]map[string]interface{}{})
+	}
+
+	switch DB.Dialector.Name() {
+	case "sqlite":
+		if err := rollup(DB.Session(&gorm.Session{DryRun: true})).Error; !errors.Is(err, clause.ErrUnsupportedExpression) {
+			t.Errorf("rollup should be unsupported, got error %v", err)
+		}
+	case "mysql":
+		assertEqualSQL(t, `SELECT name, active, SUM(age) AS total, GROUPING("active") AS subtotal FROM "users" WHERE "users"."deleted_at" IS NULL GROUP BY "name","active" WITH ROLLUP`, DB.ToSQL(rollup))
+	default:
+		assertEqualSQL(t, `SELECT name, active, SUM(age) AS total, GROUPING("active") AS subtotal FROM "users" WHERE "users"."deleted_at" IS NULL GROUP BY ROLLUP ("name","active")`, DB.ToSQL(rollup))
+	}
+
+	switch DB.Dialector.Name() {
+	case "sqlite", "mysql":
+		if err := groupingSets(DB.Session(&gorm.Session{DryRun: true})).Error; !errors.Is(err, clause.ErrUnsupportedExpression) {
+			t.Errorf("grouping sets should be unsupported, got error %v", err)
+		}
+	default:
+		assertEqualSQL(t, `SELECT name, active, SUM(age) AS total FROM "users" WHERE "users"."deleted_at" IS NULL GROUP BY "name",GROUPING SETS (("active"),())`, DB.ToSQL(groupingSets))
+	}
+
+	if DB.Dialector.Name() != "postgres" && DB.Dialector.Name() != "gaussdb" && DB.Dialector.Name() != "sqlserver" {
+		t.Skip("ROLLUP not supported")
+	}
+
+	users := []User{{Name: "groupby_rollup", Age: 10, Active: true}, {Name: "groupby_rollup", Age: 20}, {Name: "groupby_rollup", Age: 30, Active: true}}
+	DB.Create(&users)
+
+	type result struct {
+		Active   *bool
+		Total    int
+		Subtotal int
+	}
+
+	var results []result
+	if err := DB.Model(&User{}).Select("active, SUM(age) AS total, ? AS subtotal", clause.Grouping{Name: "active"}).Where("name = ?", "groupby_rollup").
+		Clauses(clause.GroupBy{Rollup: []clause.Column{{Name: "active"}}}).Order("subtotal, total").Find(&results).Error; err != nil {
+		t.Fatalf("failed to group by rollup, got error %v", err)
+	}
+
+	if len(results) != 3 || results[0].Total != 20 || results[1].Total != 40 || results[2].Total != 60 || results[2].Subtotal != 1 {
+		t.Errorf("invalid rollup results %+v", results)
+	}
+}
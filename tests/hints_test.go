@@ -0,0 +1,72 @@
+package tests_test
+
+import (
+	"context"
+	"testing"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	. "gorm.io/gorm/utils/tests"
+)
+
+func TestHints(t *testing.T) {
+	hints := []clause.Hint{clause.OptimizerHints("MAX_EXECUTION_TIME(1000)"), clause.UseIndex("idx_users_name").ForJoin()}
+
+	sql := DB.ToSQL(func(tx *gorm.DB) *gorm.DB {
+		return tx.Hints(hints...).Where("name = ?", "hints").Find(&[]User{})
+	})
+	assertEqualSQL(t, `SELECT * FROM "users" WHERE name = 'hints' AND "users"."deleted_at" IS NULL`, sql)
+
+	db, err := OpenTestConnection(&gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to connect database, got error %v", err)
+	}
+
+	if sqlDB, err := db.DB(); err == nil {
+		defer sqlDB.Close()
+	}
+
+	if db.ClauseBuilders == nil {
+		db.ClauseBuilders = map[string]clause.ClauseBuilder{}
+	}
+	db.ClauseBuilders["HINT"] = gorm.MySQLClauseBuilders["HINT"]
+
+	sql = db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+		return tx.Hints(hints...).Hints(clause.Hint{Position: clause.HintStatement, Values: []string{"/* prefix ? */"}, Vars: []interface{}{"hints"}}).
+			Table("users u").Joins("JOIN companies ON companies.id = u.company_id").Where("u.name = ?", "hints").Find(&[]User{})
+	})
+	assertEqualSQL(t, `/* prefix 'hints' */ SELECT /*+ MAX_EXECUTION_TIME(1000) */ "u"."id","u"."created_at","u"."updated_at","u"."deleted_at","u"."name","u"."age","u"."birthday","u"."company_id","u"."manager_id","u"."active" FROM users u USE INDEX FOR JOIN ("idx_users_name") JOIN companies ON companies.id = u.company_id WHERE u.name = 'hints' AND "u"."deleted_at" IS NULL`, sql)
+
+	sql = db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+		return tx.Model(&User{}).Hints(hints...).Where("name = ?", "hints").Update("age", 18)
+	})
+	assertEqualSQL(t, `UPDATE /*+ MAX_EXECUTION_TIME(1000) */ "users" USE INDEX FOR JOIN ("idx_users_name") SET "age"=18,"updated_at"=? WHERE name = 'hints' AND "users"."deleted_at" IS NULL`, sql)
+
+	// index hints are not supported in single-table delete
+	sql = db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+		return tx.Unscoped().Hints(hints...).Where("name = ?", "hints").Delete(&User{})
+	})
+	assertEqualSQL(t, `DELETE /*+ MAX_EXECUTION_TIME(1000) */ FROM "users" WHERE name = 'hints'`, sql)
+
+	// dialector drops the index hints
+	db.ClauseBuilders["HINT"] = func(c clause.Clause, builder clause.Builder) {
+		if hint, ok := c.Expression.(clause.Hint); ok && hint.Type == clause.HintOptimizer {
+			hint.Build(builder)
+		}
+	}
+
+	sql = db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+		return tx.Hints(hints...).Where("name = ?", "hints").Find(&[]User{})
+	})
+	assertEqualSQL(t, `SELECT /*+ MAX_EXECUTION_TIME(1000) */ * FROM "users" WHERE name = 'hints' AND "users"."deleted_at" IS NULL`, sql)
+
+	sql = db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+		gorm.G[User](tx).Hints(clause.OptimizerHints("NO_ICP(users)")).Where("name = ?", "hints").Find(context.Background())
+		return tx
+	})
+	assertEqualSQL(t, `SELECT /*+ NO_ICP(users) */ * FROM "users" WHERE name = 'hints' AND "users"."deleted_at" IS NULL`, sql)
+
+	if err := db.Hints(hints...).Where("name = ?", "hints").Find(&[]User{}).Error; err != nil {
+		t.Errorf("unsupported hints should be dropped, got error %v", err)
+	}
+}
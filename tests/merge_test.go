@@ -0,0 +1,129 @@
+package tests_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	. "gorm.io/gorm/utils/tests"
+)
+
+// only postgres, gaussdb, sqlserver support merge
+func TestMerge(t *testing.T) {
+	if DB.Dialector.Name() != "postgres" && DB.Dialector.Name() != "gaussdb" && DB.Dialector.Name() != "sqlserver" {
+		t.Skip()
+	}
+
+	users := []User{*GetUser("merge_1", Config{}), *GetUser("merge_2", Config{})}
+	if err := DB.Create(&users).Error; err != nil {
+		t.Fatalf("errors happened when create: %v", err)
+	}
+
+	source := clause.MergeSource{
+		Values: &clause.Values{
+			Columns: []clause.Column{{Name: "id"}, {Name: "name"}, {Name: "age"}},
+			Values:  [][]interface{}{{users[0].ID, "merge_1_new", 20}, {users[1].ID, "merge_2", 0}},
+		},
+		Alias: "s",
+	}
+
+	result := DB.Model(&User{}).Merge(clause.Merge{
+		Using: source,
+		On:    []clause.Expression{clause.Expr{SQL: "users.id = s.id"}},
+		Whens: []clause.MergeWhen{
+			{Matched: true, Condition: clause.Expr{SQL: "s.age = ?", Vars: []interface{}{0}}, Delete: true},
+			{Matched: true, Update: clause.Set{{Column: clause.Column{Name: "name"}, Value: clause.Column{Table: "s", Name: "name"}}}},
+		},
+	})
+	if result.Error != nil || result.RowsAffected != 2 {
+		t.Fatalf("failed to merge, affected %v, got error: %v", result.RowsAffected, result.Error)
+	}
+
+	var merged User
+	if err := DB.First(&merged, users[0].ID).Error; err != nil || merged.Name != "merge_1_new" {
+		t.Errorf("user should be updated, got %+v, error: %v", merged, err)
+	}
+
+	if err := DB.Unscoped().First(&User{}, users[1].ID).Error; err != gorm.ErrRecordNotFound {
+		t.Errorf("user should be deleted, got error: %v", err)
+	}
+
+	rows, err := gorm.G[User](DB).Merge(context.Background(), clause.Merge{
+		Using: clause.MergeSource{Subquery: DB.Model(&User{}).Select("id", "name").Where("id = ?", users[0].ID), Alias: "s"},
+		On:    []clause.Expression{clause.Expr{SQL: "users.id = s.id"}},
+		Whens: []clause.MergeWhen{{Matched: true, Update: clause.Set{{Column: clause.Column{Name: "age"}, Value: 30}}}},
+	})
+	if err != nil || rows != 1 {
+		t.Errorf("failed to merge with generics, affected %v, got error: %v", rows, err)
+	}
+}
+
+func TestMergeToSQL(t *testing.T) {
+	db, _ := gorm.Open(DummyDialector{}, nil)
+	gorm.RegisterClauseBuilders(db, gorm.PostgresClauseBuilders)
+
+	sql := db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+		return tx.Model(&User{}).Merge(clause.Merge{
+			Using: clause.MergeSource{Subquery: DB.Table("user_stagings").Where("age > ?", 18), Alias: "s"},
+			On:    []clause.Expression{clause.Eq{Column: clause.Column{Table: clause.CurrentTable, Name: "id"}, Value: clause.Column{Table: "s", Name: "id"}}},
+			Whens: []clause.MergeWhen{
+				{Matched: true, Condition: clause.Expr{SQL: "s.active = ?", Vars: []interface{}{false}}, Delete: true},
+				{Matched: true, Update: clause.Set{{Column: clause.Column{Name: "name"}, Value: clause.Column{Table: "s", Name: "name"}}}},
+				{Insert: &clause.Values{Columns: []clause.Column{{Name: "name"}}, Values: [][]interface{}{{clause.Column{Table: "s", Name: "name"}}}}},
+			},
+		})
+	})
+	assertEqualSQL(t, "MERGE INTO `users` USING (SELECT * FROM `user_stagings` WHERE age > 18) AS `s` ON `users`.`id` = `s`.`id` WHEN MATCHED AND s.active = false THEN DELETE WHEN MATCHED THEN UPDATE SET `name`=`s`.`name` WHEN NOT MATCHED THEN INSERT (`name`) VALUES (`s`.`name`)", sql)
+
+	if err := db.Session(&gorm.Session{DryRun: true}).Merge(clause.Merge{}).Error; err != gorm.ErrModelValueRequired {
+		t.Errorf("should returns error for missing model, but got %v", err)
+	}
+}
+
+func TestMergeWithUnsupportedDriver(t *testing.T) {
+	db, _ := gorm.Open(DummyDialector{}, nil)
+	merge := clause.Merge{
+		Using: clause.MergeSource{Table: clause.Table{Name: "user_stagings", Alias: "s"}},
+		On:    []clause.Expression{clause.Expr{SQL: "users.id = s.id"}},
+		Whens: []clause.MergeWhen{{Matched: true, Delete: true}},
+	}
+
+	if err := db.Session(&gorm.Session{DryRun: true}).Model(&User{}).Merge(merge).Error; !errors.Is(err, gorm.ErrUnsupportedDriver) {
+		t.Errorf("should returns unsupported driver error without merge clause builder, but got %v", err)
+	}
+
+	if _, err := gorm.G[User](db.Session(&gorm.Session{DryRun: true})).Where("age > ?", 18).Merge(context.Background(), merge); !errors.Is(err, gorm.ErrUnsupportedDriver) {
+		t.Errorf("should returns unsupported driver error without merge clause builder, but got %v", err)
+	}
+}
+
+type sqlserverMergeDialector struct {
+	DummyDialector
+}
+
+func (sqlserverMergeDialector) Name() string {
+	return "sqlserver"
+}
+
+func TestMergeToSQLWithSQLServer(t *testing.T) {
+	db, _ := gorm.Open(sqlserverMergeDialector{}, nil)
+	gorm.RegisterClauseBuilders(db, gorm.SQLServerClauseBuilders)
+
+	merge := clause.Merge{
+		Using: clause.MergeSource{Table: clause.Table{Name: "user_stagings", Alias: "s"}},
+		On:    []clause.Expression{clause.Expr{SQL: "users.id = s.id"}},
+		Whens: []clause.MergeWhen{{Matched: true, Update: clause.Set{{Column: clause.Column{Name: "name"}, Value: clause.Column{Table: "s", Name: "name"}}}}},
+	}
+
+	sql := db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+		return tx.Model(&User{}).Merge(merge)
+	})
+	assertEqualSQL(t, "MERGE INTO `users` USING `user_stagings` `s` ON users.id = s.id WHEN MATCHED THEN UPDATE SET `name`=`s`.`name`;", sql)
+
+	merge.Whens = append(merge.Whens, clause.MergeWhen{})
+	if err := db.Session(&gorm.Session{DryRun: true}).Model(&User{}).Merge(merge).Error; !errors.Is(err, clause.ErrUnsupportedExpression) {
+		t.Errorf("should returns error for merge branch doing nothing on sqlserver, but got %v", err)
+	}
+}
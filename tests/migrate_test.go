@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"math/rand"
 	"reflect"
+	"regexp"
 	"strconv"
 	"strings"
 	"testing"
@@ -14,6 +15,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"gorm.io/driver/gaussdb"
 	"gorm.io/driver/postgres"
+	"gorm.io/driver/sqlite"
 
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -2197,3 +2199,182 @@ func TestAutoMigrateDecimal(t *testing.T) {
 		decimalColumnsTest[MigrateDecimalColumn, MigrateDecimalColumn2](t, expectedSql)
 	}
 }
+
+func TestMigrateGeneratedColumn(t *testing.T) {
+	type GeneratedProduct struct {
+		ID       uint
+		Price    int
+		Quantity int
+		Total    int `gorm:"generated:price * quantity;stored"`
+	}
+
+	DB.Migrator().DropTable(&GeneratedProduct{})
+	if err := DB.AutoMigrate(&GeneratedProduct{}); err != nil {
+		t.Fatalf("failed to migrate generated column, got error %v", err)
+	}
+
+	product := GeneratedProduct{Price: 3, Quantity: 4, Total: 100}
+	if err := DB.Create(&product).Error; err != nil {
+		t.Fatalf("failed to create product with generated column, got error %v", err)
+	}
+
+	var result GeneratedProduct
+	if err := DB.First(&result, product.ID).Error; err != nil || result.Total != 12 {
+		t.Fatalf("generated column should be computed by database, got %v, error %v", result.Total, err)
+	}
+
+	result.Quantity = 5
+	if err := DB.Save(&result).Error; err != nil {
+		t.Fatalf("failed to save product with generated column, got error %v", err)
+	}
+
+	if err := DB.First(&result, product.ID).Error; err != nil || result.Total != 15 {
+		t.Fatalf("generated column should be recomputed by database, got %v, error %v", result.Total, err)
+	}
+
+	// the generation is migrated if the column types of the dialector report it
+	db := DB
+	if columnTypes, err := DB.Migrator().ColumnTypes(&GeneratedProduct{}); err != nil {
+		t.Fatalf("failed to get column types, got error %v", err)
+	} else if !reportsGeneration(columnTypes) {
+		if DB.Dialector.Name() != "sqlite" {
+			t.Skip("the column types of the dialector don't report the generation")
+		}
+
+		sqlDB, _ := DB.DB()
+		if db, err = gorm.Open(generatedColumnsDialector{Dialector: &sqlite.Dialector{Conn: sqlDB}, table: "generated_products"}, &gorm.Config{}); err != nil {
+			t.Fatalf("failed to open db, got error %v", err)
+		}
+	}
+
+	// sqlite couldn't add stored generated columns
+	type GeneratedProductV2 struct {
+		ID       uint
+		Price    int
+		Quantity int
+		Total    int `gorm:"generated:price * quantity * 2;virtual"`
+	}
+
+	if err := db.Table("generated_products").AutoMigrate(&GeneratedProductV2{}); err != nil {
+		t.Fatalf("failed to migrate changed generated column, got error %v", err)
+	}
+
+	if err := db.Table("generated_products").First(&result, product.ID).Error; err != nil || result.Total != 30 {
+		t.Fatalf("generated column should be recreated, got %v, error %v", result.Total, err)
+	}
+
+	session := db.Session(&gorm.Session{Logger: Tracer{
+		Logger: DB.Config.Logger,
+		Test: func(ctx context.Context, begin time.Time, fc func() (sql string, rowsAffected int64), err error) {
+			sql, _ := fc()
+			if strings.HasPrefix(sql, "ALTER TABLE") || strings.HasPrefix(sql, "CREATE TABLE") || strings.HasPrefix(sql, "DROP TABLE") {
+				t.Errorf("unchanged generated column shouldn't be migrated, sql=%s", sql)
+			}
+		},
+	}})
+	if err := session.Table("generated_products").AutoMigrate(&GeneratedProductV2{}); err != nil {
+		t.Fatalf("failed to migrate unchanged generated column, got error %v", err)
+	}
+
+	type GeneratedProductV3 struct {
+		ID       uint
+		Price    int
+		Quantity int
+		Total    int
+	}
+
+	if err := db.Table("generated_products").AutoMigrate(&GeneratedProductV3{}); err != nil {
+		t.Fatalf("failed to migrate generated column to normal column, got error %v", err)
+	}
+
+	if err := db.Table("generated_products").First(&result, product.ID).Error; err != nil || result.Total != 30 {
+		t.Fatalf("generated values should be kept in the normal column, got %v, error %v", result.Total, err)
+	}
+
+	if err := db.Table("generated_products").Where("id = ?", product.ID).Update("total", 7).Error; err != nil {
+		t.Fatalf("normal column should be updatable, got error %v", err)
+	}
+}
+
+func reportsGeneration(columnTypes []gorm.ColumnType) bool {
+	for _, columnType := range columnTypes {
+		if generatedColumnType, ok := columnType.(gorm.GeneratedColumnType); ok {
+			if _, ok := generatedColumnType.GenerationExpression(); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// generatedColumnsDialector reports the generation of the columns parsed from the table definition of sqlite, like
+// the dialectors implementing gorm.GeneratedColumnType
+type generatedColumnsDialector struct {
+	gorm.Dialector
+	table string
+}
+
+func (d generatedColumnsDialector) Migrator(db *gorm.DB) gorm.Migrator {
+	return generatedColumnsMigrator{Migrator: d.Dialector.Migrator(db), db: db, table: d.table}
+}
+
+type generatedColumnsMigrator struct {
+	gorm.Migrator
+	db    *gorm.DB
+	table string
+}
+
+func (m generatedColumnsMigrator) ColumnTypes(value interface{}) ([]gorm.ColumnType, error) {
+	columnTypes, err := m.Migrator.ColumnTypes(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var ddl string
+	if err := m.db.Session(&gorm.Session{NewDB: true}).Raw("SELECT sql FROM sqlite_master WHERE type = ? AND tbl_name = ?", "table", m.table).Scan(&ddl).Error; err != nil {
+		return nil, err
+	}
+
+	for idx, columnType := range columnTypes {
+		if ct, ok := columnType.(migrator.ColumnType); ok {
+			ct.GenerationExpressionValue = sql.NullString{Valid: true}
+			ct.GeneratedStoredValue = sql.NullBool{Valid: true}
+			re := regexp.MustCompile("`" + ct.Name() + "` [^,]*GENERATED ALWAYS AS \\((.+?)\\) (STORED|VIRTUAL)")
+			if matches := re.FindStringSubmatch(ddl); len(matches) == 3 {
+				ct.GenerationExpressionValue.String, ct.GeneratedStoredValue.Bool = matches[1], matches[2] == "STORED"
+			}
+			columnTypes[idx] = ct
+		}
+	}
+	return columnTypes, nil
+}
+
+func TestGeneratedColumnFullDataType(t *testing.T) {
+	type GeneratedOrder struct {
+		ID       uint
+		Price    int
+		Quantity int
+		Total    int `gorm:"generated:price * quantity"`
+		Discount int `gorm:"generated:price / 2;virtual"`
+	}
+
+	postgresDB, _ := gorm.Open(postgres.New(postgres.Config{DSN: postgresDSN}), &gorm.Config{DisableAutomaticPing: true})
+
+	for _, c := range []struct {
+		DB     *gorm.DB
+		Field  string
+		Expect string
+	}{
+		{postgresDB, "Total", "bigint GENERATED ALWAYS AS (price * quantity) STORED"},
+		{postgresDB, "Discount", "bigint GENERATED ALWAYS AS (price / 2) VIRTUAL"},
+	} {
+		stmt := &gorm.Statement{DB: c.DB}
+		if err := stmt.Parse(&GeneratedOrder{}); err != nil {
+			t.Fatalf("failed to parse, got error %v", err)
+		}
+
+		if expr := c.DB.Migrator().FullDataTypeOf(stmt.Schema.LookUpField(c.Field)); expr.SQL != c.Expect {
+			t.Errorf("%v: expects %v for %v, got %v", c.DB.Dialector.Name(), c.Expect, c.Field, expr.SQL)
+		}
+	}
+}
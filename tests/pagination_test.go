@@ -0,0 +1,110 @@
+package tests_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	. "gorm.io/gorm/utils/tests"
+)
+
+func TestPaginate(t *testing.T) {
+	users := make([]User, 0, 7)
+	for idx, age := range []uint{3, 1, 2, 3, 1, 2, 3} {
+		user := GetUser("paginate", Config{})
+		user.Age = age
+		user.Active = idx%2 == 0
+		users = append(users, *user)
+	}
+	DB.Create(&users)
+
+	var expected []uint
+	DB.Model(&User{}).Where("name = ?", "paginate").Order("age DESC, id").Pluck("id", &expected)
+
+	var (
+		ids        []uint
+		pages      [][]User
+		pagination = gorm.Pagination{Limit: 3, Columns: []clause.OrderByColumn{{Column: clause.Column{Name: "Age"}, Desc: true}}}
+	)
+
+	for {
+		var page []User
+		if err := DB.Where("name = ?", "paginate").Order("name").Paginate(&page, &pagination).Error; err != nil {
+			t.Fatalf("failed to paginate, got error: %v", err)
+		}
+
+		for _, user := range page {
+			ids = append(ids, user.ID)
+		}
+		pages = append(pages, page)
+
+		if (len(pages) == 1) != (pagination.Prev == "") {
+			t.Errorf("page #%v has invalid previous cursor %v", len(pages), pagination.Prev)
+		}
+
+		if pagination.Next == "" {
+			break
+		}
+		pagination.Cursor = pagination.Next
+	}
+
+	if len(pages) != 3 || !reflect.DeepEqual(ids, expected) {
+		t.Fatalf("invalid pages, expects %v, got %v in %v pages", expected, ids, len(pages))
+	}
+
+	var prevPage []User
+	pagination.Cursor = pagination.Prev
+	if err := DB.Where("name = ?", "paginate").Paginate(&prevPage, &pagination).Error; err != nil {
+		t.Fatalf("failed to paginate backward, got error: %v", err)
+	}
+
+	if len(prevPage) != 3 || prevPage[0].ID != pages[1][0].ID || prevPage[2].ID != pages[1][2].ID || pagination.Prev == "" || pagination.Next == "" {
+		t.Errorf("invalid previous page, expects %+v, got %+v", pages[1], prevPage)
+	}
+
+	pagination.Cursor = pagination.Prev
+	generics, err := gorm.G[User](DB).Where("name = ?", "paginate").Paginate(context.Background(), &pagination)
+	if err != nil || len(generics) != 3 || generics[0].ID != pages[0][0].ID || pagination.Prev != "" || pagination.Next == "" {
+		t.Errorf("invalid first page with generics, got %+v, error: %v", generics, err)
+	}
+
+	if err := DB.Paginate(&[]User{}, &gorm.Pagination{Limit: 3, Cursor: "invalid"}).Error; !errors.Is(err, gorm.ErrInvalidData) {
+		t.Errorf("should returns error for invalid cursor, but got %v", err)
+	}
+
+	if err := DB.Paginate(&[]User{}, &gorm.Pagination{Limit: 3, Columns: []clause.OrderByColumn{{Column: clause.Column{Name: "unknown"}}}}).Error; !errors.Is(err, gorm.ErrInvalidField) {
+		t.Errorf("should returns error for invalid column, but got %v", err)
+	}
+
+	// the cursor of the pagination ordered by age couldn't be used by others
+	if err := DB.Paginate(&[]User{}, &gorm.Pagination{Limit: 3, Cursor: pagination.Next, Columns: []clause.OrderByColumn{{Column: clause.Column{Name: "Name"}}}}).Error; !errors.Is(err, gorm.ErrInvalidData) {
+		t.Errorf("should returns error for cursor of other columns, but got %v", err)
+	}
+
+	// {"c":["id"],"v":["jinzhu"]}
+	if err := DB.Paginate(&[]User{}, &gorm.Pagination{Limit: 3, Cursor: "eyJjIjpbImlkIl0sInYiOlsiamluemh1Il19"}).Error; !errors.Is(err, gorm.ErrInvalidData) {
+		t.Errorf("should returns error for cursor value of invalid type, but got %v", err)
+	}
+
+	if err := DB.Paginate(&[]User{}, nil).Error; !errors.Is(err, gorm.ErrInvalidData) {
+		t.Errorf("should returns error for nil pagination, but got %v", err)
+	}
+
+	if err := DB.Paginate(&User{}, &gorm.Pagination{Limit: 3}).Error; !errors.Is(err, gorm.ErrInvalidData) {
+		t.Errorf("should returns error for non-slice dest, but got %v", err)
+	}
+}
+
+func TestPaginateToSQL(t *testing.T) {
+	var users []User
+	pagination := gorm.Pagination{Limit: 10, Columns: []clause.OrderByColumn{{Column: clause.Column{Name: "age"}, Desc: true}, {Column: clause.Column{Name: "name"}}}}
+
+	pagination.Cursor = "eyJjIjpbImFnZSBERVNDIiwibmFtZSIsImlkIl0sInYiOlsyMCwiamluemh1IiwxXX0"
+	sql := DB.ToSQL(func(tx *gorm.DB) *gorm.DB {
+		return tx.Paginate(&users, &pagination)
+	})
+	assertEqualSQL(t, `SELECT * FROM "users" WHERE ("users"."age" < 20 OR ("users"."age" = 20 AND "users"."name" > "jinzhu") OR ("users"."age" = 20 AND "users"."name" = "jinzhu" AND "users"."id" > 1)) AND "users"."deleted_at" IS NULL ORDER BY "users"."age" DESC,"users"."name","users"."id" LIMIT 11`, sql)
+}
@@ -1,8 +1,10 @@
 package tests_test
 
 import (
+	"context"
 	"database/sql"
 	"database/sql/driver"
+	"errors"
 	"fmt"
 	"reflect"
 	"regexp"
@@ -379,6 +381,92 @@ func TestFindInBatchesWithOffsetLimit(t *testing.T) {
 	}
 }
 
+func TestFindInBatchesWithKeyset(t *testing.T) {
+	users := make([]User, 0, 7)
+	for _, age := range []uint{3, 1, 2, 3, 1, 2, 3} {
+		user := GetUser("find_in_batches_keyset", Config{})
+		user.Age = age
+		users = append(users, *user)
+	}
+	DB.Create(&users)
+
+	var expected []uint
+	DB.Model(&User{}).Where("name = ?", "find_in_batches_keyset").Order("age DESC, id").Pluck("id", &expected)
+
+	var (
+		results []User
+		ids     []uint
+		batches int
+	)
+
+	columns := []clause.OrderByColumn{{Column: clause.Column{Name: "Age"}, Desc: true}}
+	if result := DB.Where("name = ?", "find_in_batches_keyset").FindInBatchesByKeyset(&results, 3, columns, func(tx *gorm.DB, batch int) error {
+		batches = batch
+		for _, user := range results {
+			ids = append(ids, user.ID)
+		}
+		return nil
+	}); result.Error != nil || result.RowsAffected != 7 {
+		t.Errorf("failed to batch find with keyset, got error %v, rows affected: %v", result.Error, result.RowsAffected)
+	}
+
+	if batches != 3 || !reflect.DeepEqual(ids, expected) {
+		t.Errorf("invalid batches, expects %v, got %v in %v batches", expected, ids, batches)
+	}
+
+	ids = ids[:0]
+	if err := gorm.G[User](DB).Where("name = ?", "find_in_batches_keyset").FindInBatchesByKeyset(context.Background(), 2, columns, func(data []User, batch int) error {
+		for _, user := range data {
+			ids = append(ids, user.ID)
+		}
+		return nil
+	}); err != nil || !reflect.DeepEqual(ids, expected) {
+		t.Errorf("invalid batches with generics, expects %v, got %v, error: %v", expected, ids, err)
+	}
+
+	noop := func(tx *gorm.DB, batch int) error { return nil }
+	if err := DB.FindInBatchesByKeyset(&results, 2, []clause.OrderByColumn{{Column: clause.Column{Name: "unknown"}}}, noop).Error; !errors.Is(err, gorm.ErrInvalidField) {
+		t.Errorf("should returns error for invalid keyset column, but got %v", err)
+	}
+
+	if err := DB.Order("name").FindInBatchesByKeyset(&results, 2, columns, noop).Error; !errors.Is(err, gorm.ErrInvalidData) {
+		t.Errorf("should returns error for the order preceding the keyset, but got %v", err)
+	}
+}
+
+func TestFindInBatchesWithCompositePrimaryKey(t *testing.T) {
+	type BatchItem struct {
+		Group string `gorm:"primaryKey"`
+		Seq   int    `gorm:"primaryKey;autoIncrement:false"`
+		Name  string
+	}
+
+	DB.Migrator().DropTable(&BatchItem{})
+	if err := DB.AutoMigrate(&BatchItem{}); err != nil {
+		t.Fatalf("failed to migrate, got error %v", err)
+	}
+
+	items := []BatchItem{{"b", 1, "b1"}, {"a", 2, "a2"}, {"a", 1, "a1"}, {"b", 2, "b2"}, {"c", 1, "c1"}}
+	DB.Create(&items)
+
+	var (
+		results []BatchItem
+		names   []string
+	)
+	if result := DB.FindInBatches(&results, 2, func(tx *gorm.DB, batch int) error {
+		for _, item := range results {
+			names = append(names, item.Name)
+		}
+		return nil
+	}); result.Error != nil || result.RowsAffected != 5 {
+		t.Errorf("failed to batch find with composite primary key, got error %v, rows affected: %v", result.Error, result.RowsAffected)
+	}
+
+	if !reflect.DeepEqual(names, []string{"a1", "a2", "b1", "b2", "c1"}) {
+		t.Errorf("invalid batches, got %v", names)
+	}
+}
+
 func TestFindInBatchesWithError(t *testing.T) {
 	if name := DB.Dialector.Name(); name == "sqlserver" {
 		t.Skip("skip sqlserver due to it will raise data race for invalid sql")
@@ -1471,3 +1559,110 @@ func TestQueryScanToArray(t *testing.T) {
 		t.Error("users[1] should be empty")
 	}
 }
+
+func TestQueryWithPredicates(t *testing.T) {
+	users := []User{
+		*GetUser("predicate_1", Config{Pets: 1}),
+		*GetUser("Predicate_2", Config{}),
+		*GetUser("predicate_3", Config{}),
+	}
+	users[0].Age, users[1].Age, users[2].Age = 10, 20, 30
+	users[2].Company = Company{Name: "predicate"}
+	DB.Create(&users)
+
+	var results []User
+	if err := DB.Where(clause.ILike{Column: "name", Value: "PREDICATE_%"}).Where(clause.Between{Column: "age", From: 15, To: 30}).Order("age").Find(&results).Error; err != nil {
+		t.Fatalf("failed to query with predicates, got error: %v", err)
+	}
+
+	if len(results) != 2 || results[0].Name != "Predicate_2" || results[1].Name != "predicate_3" {
+		t.Errorf("invalid results with ilike and between, got %+v", results)
+	}
+
+	results = nil
+	if err := DB.Where(clause.ILike{Column: "name", Value: "predicate_%"}).Not(clause.IsNull{Column: "company_id"}).Find(&results).Error; err != nil {
+		t.Fatalf("failed to query with predicates, got error: %v", err)
+	}
+
+	if len(results) != 1 || results[0].Name != "predicate_3" {
+		t.Errorf("invalid results with is not null, got %+v", results)
+	}
+
+	results = nil
+	if err := DB.Where(clause.ILike{Column: "name", Value: "predicate_%"}).Where(clause.Exists{
+		Subquery: DB.Model(&Pet{}).Select("1").Where("pets.user_id = users.id"),
+	}).Find(&results).Error; err != nil {
+		t.Fatalf("failed to query with exists, got error: %v", err)
+	}
+
+	if len(results) != 1 || results[0].Name != "predicate_1" {
+		t.Errorf("invalid results with exists, got %+v", results)
+	}
+
+	var count int64
+	if err := DB.Model(&User{}).Where(clause.ILike{Column: "name", Value: "predicate_%"}).Not(clause.Exists{
+		Subquery: DB.Model(&Pet{}).Select("1").Where("pets.user_id = users.id"),
+	}).Not(clause.Between{Column: "age", From: 25, To: 35}).Count(&count).Error; err != nil || count != 1 {
+		t.Errorf("invalid count with not exists and not between, got %v, error: %v", count, err)
+	}
+}
+
+func TestQueryWithRegexAndQuantifiedPredicates(t *testing.T) {
+	users := []User{
+		*GetUser("quantified_1", Config{Pets: 1}),
+		*GetUser("quantified_2", Config{}),
+		*GetUser("quantified_3", Config{Pets: 2}),
+	}
+	DB.Create(&users)
+
+	petOwners := DB.Model(&Pet{}).Select("user_id").Where("user_id IS NOT NULL")
+
+	var results []User
+	if err := DB.Where("name LIKE ?", "quantified_%").Where(clause.Any{Column: "id", Subquery: petOwners}).Order("id").Find(&results).Error; err != nil {
+		t.Fatalf("failed to query with any, got error: %v", err)
+	}
+
+	if len(results) != 2 || results[0].Name != "quantified_1" || results[1].Name != "quantified_3" {
+		t.Errorf("invalid results with any, got %+v", results)
+	}
+
+	results = nil
+	if err := DB.Where("name LIKE ?", "quantified_%").Where(clause.All{Column: "id", Operator: "<>", Subquery: petOwners}).Find(&results).Error; err != nil {
+		t.Fatalf("failed to query with all, got error: %v", err)
+	}
+
+	if len(results) != 1 || results[0].Name != "quantified_2" {
+		t.Errorf("invalid results with all, got %+v", results)
+	}
+
+	results = nil
+	err := DB.Where("name LIKE ?", "quantified_%").Where(clause.Any{
+		Column: "id", Operator: ">", Subquery: DB.Model(&User{}).Select("id").Where("name = ?", "quantified_1"),
+	}).Find(&results).Error
+	if DB.Dialector.Name() == "sqlite" {
+		if !errors.Is(err, clause.ErrUnsupportedExpression) {
+			t.Errorf("expects unsupported expression error for > any on sqlite, got %v", err)
+		}
+	} else if err != nil || len(results) != 2 {
+		t.Errorf("invalid results with > any, got %+v, error: %v", results, err)
+	}
+
+	results = nil
+	err = DB.Where(clause.Regex{Column: "name", Value: "^quantified_[12]$"}).Not(clause.Regex{Column: "name", Value: "2$"}).Find(&results).Error
+	switch DB.Dialector.Name() {
+	case "sqlite":
+		// sqlite has no REGEXP function unless registered by the connection
+		if err == nil {
+			t.Errorf("expects error for regex without the REGEXP function, got %+v", results)
+		}
+	case "sqlserver":
+		// REGEXP_LIKE requires sqlserver 2025
+		if err == nil && (len(results) != 1 || results[0].Name != "quantified_1") {
+			t.Errorf("invalid results with regex, got %+v", results)
+		}
+	default:
+		if err != nil || len(results) != 1 || results[0].Name != "quantified_1" {
+			t.Errorf("invalid results with regex, got %+v, error: %v", results, err)
+		}
+	}
+}
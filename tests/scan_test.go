@@ -163,6 +163,66 @@ func TestScanRows(t *testing.T) {
 	}
 }
 
+func TestIterate(t *testing.T) {
+	users := []User{{Name: "IterateUser1", Age: 1}, {Name: "IterateUser2", Age: 10}, {Name: "IterateUser3", Age: 20}}
+	DB.Create(&users)
+
+	var (
+		user    User
+		results []User
+	)
+	for idx, err := range DB.Where("name like ?", "IterateUser%").Order("id").Iterate(&user) {
+		if err != nil {
+			t.Fatalf("should get no error, but got %v", err)
+		}
+
+		if idx != len(results) {
+			t.Errorf("invalid index, expects %v, got %v", len(results), idx)
+		}
+		results = append(results, user)
+	}
+
+	if len(results) != len(users) {
+		t.Fatalf("should iterate all users, got %v", len(results))
+	}
+
+	for idx, result := range results {
+		AssertObjEqual(t, result, users[idx], "ID", "Name", "Age")
+	}
+
+	var names []string
+	type Result struct {
+		Name string
+	}
+	var result Result
+	for _, err := range DB.Table("users").Select("name").Where("name like ?", "IterateUser%").Order("id").Iterate(&result) {
+		if err != nil {
+			t.Fatalf("should get no error, but got %v", err)
+		}
+
+		names = append(names, result.Name)
+		if len(names) == 2 {
+			break
+		}
+	}
+
+	if !reflect.DeepEqual(names, []string{"IterateUser1", "IterateUser2"}) {
+		t.Errorf("invalid names when breaking the loop, got %v", names)
+	}
+
+	// the rows should be closed after breaking the loop
+	var count int64
+	if err := DB.Model(&User{}).Where("name like ?", "IterateUser%").Count(&count).Error; err != nil || count != 3 {
+		t.Errorf("failed to count after iterating, got error %v, count %v", err, count)
+	}
+
+	for _, err := range DB.Table("invalid_iterate_table").Iterate(&result) {
+		if err == nil {
+			t.Errorf("should returns error for invalid table")
+		}
+	}
+}
+
 func TestScanRowsNullValuesScanToFieldDefault(t *testing.T) {
 	DB.Save(&User{})
 
@@ -3,6 +3,7 @@ package tests_test
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
 	"strings"
@@ -283,3 +284,135 @@ func TestSerializerWithAnyType(t *testing.T) {
 		t.Error("expected Data to be non-nil")
 	}
 }
+
+// rotatingKeyring keyring could be rotated in place
+type rotatingKeyring struct {
+	schema.StaticKeyring
+}
+
+type EncryptedUser struct {
+	ID    uint
+	Name  string
+	SSN   string  `gorm:"serializer:encrypted"`
+	Phone *string `gorm:"serializer:encrypted"`
+	Email string  `gorm:"serializer:encrypted_deterministic"`
+}
+
+func TestEncryptedSerializer(t *testing.T) {
+	keyring := &rotatingKeyring{StaticKeyring: schema.StaticKeyring{Current: "k1", Keys: map[string][]byte{
+		"k1": []byte("0123456789abcdef0123456789abcdef"),
+	}}}
+	schema.RegisterSerializer("encrypted", schema.EncryptedSerializer{Keyring: keyring})
+	schema.RegisterSerializer("encrypted_deterministic", schema.EncryptedSerializer{Keyring: keyring, Deterministic: true})
+
+	DB.Migrator().DropTable(&EncryptedUser{})
+	if err := DB.AutoMigrate(&EncryptedUser{}); err != nil {
+		t.Fatalf("failed to migrate, got error %v", err)
+	}
+
+	phone := "555-0100"
+	users := []EncryptedUser{
+		{Name: "encrypted_1", SSN: "111-11-1111", Phone: &phone, Email: "encrypted_1@example.org"},
+		{Name: "encrypted_2", SSN: "222-22-2222", Email: "encrypted_2@example.org"},
+		{Name: "encrypted_3", SSN: "333-33-3333", Email: "encrypted_3@example.org"},
+	}
+	if err := DB.Create(&users).Error; err != nil {
+		t.Fatalf("failed to create encrypted users, got error %v", err)
+	}
+
+	assertKeyID := func(keyID string) {
+		t.Helper()
+		var rows []struct {
+			SSN   string
+			Phone *string
+			Email string
+		}
+		DB.Table("encrypted_users").Order("id").Find(&rows)
+		for _, row := range rows {
+			if !strings.HasPrefix(row.SSN, keyID+":") || !strings.HasPrefix(row.Email, keyID+":") || strings.Contains(row.SSN, "-") {
+				t.Errorf("column should be encrypted with key %v, got %+v", keyID, row)
+			}
+		}
+		if len(rows) != 3 || rows[0].Phone == nil || rows[1].Phone != nil {
+			t.Errorf("nil value should be stored as NULL, got %+v", rows)
+		}
+	}
+	assertKeyID("k1")
+
+	var result EncryptedUser
+	if err := DB.First(&result, users[0].ID).Error; err != nil {
+		t.Fatalf("failed to query encrypted user, got error %v", err)
+	}
+	AssertObjEqual(t, result, users[0], "ID", "Name", "SSN", "Phone", "Email")
+
+	var found EncryptedUser
+	if err := DB.Where(&EncryptedUser{Email: "encrypted_2@example.org"}).First(&found).Error; err != nil || found.ID != users[1].ID {
+		t.Errorf("should find user by deterministic encrypted column, got %+v, error %v", found, err)
+	}
+
+	// rotate key
+	keyring.Keys["k2"] = []byte("fedcba9876543210fedcba9876543210")
+	keyring.Current = "k2"
+
+	if err := DB.First(&result, users[0].ID).Error; err != nil || result.SSN != users[0].SSN {
+		t.Fatalf("should decrypt with old key after rotation, got %+v, error %v", result, err)
+	}
+
+	if err := gorm.ReencryptInBatches(DB, &[]EncryptedUser{}, 0).Error; !errors.Is(err, gorm.ErrInvalidData) {
+		t.Fatalf("should returns error for invalid batch size, got error %v", err)
+	}
+
+	if err := gorm.ReencryptInBatches(DB, &[]EncryptedUser{}, 2).Error; err != nil {
+		t.Fatalf("failed to re-encrypt, got error %v", err)
+	}
+	assertKeyID("k2")
+
+	delete(keyring.Keys, "k1")
+	var results []EncryptedUser
+	if err := DB.Order("id").Find(&results).Error; err != nil || len(results) != 3 {
+		t.Fatalf("failed to query re-encrypted users, got %+v, error %v", results, err)
+	}
+
+	for idx, user := range results {
+		AssertObjEqual(t, user, users[idx], "ID", "Name", "SSN", "Phone", "Email")
+	}
+
+	var foundAfterRotation EncryptedUser
+	if err := DB.Where(&EncryptedUser{Email: "encrypted_3@example.org"}).First(&foundAfterRotation).Error; err != nil || foundAfterRotation.ID != users[2].ID {
+		t.Errorf("should find user by deterministic encrypted column with new key, got %+v, error %v", foundAfterRotation, err)
+	}
+
+	// re-encrypt the rows of the specified table
+	DB.Migrator().DropTable("encrypted_user_archives")
+	if err := DB.Table("encrypted_user_archives").AutoMigrate(&EncryptedUser{}); err != nil {
+		t.Fatalf("failed to migrate, got error %v", err)
+	}
+
+	if err := DB.Exec("INSERT INTO encrypted_user_archives SELECT * FROM encrypted_users").Error; err != nil {
+		t.Fatalf("failed to archive encrypted users, got error %v", err)
+	}
+
+	keyring.Keys["k3"] = []byte("abcdef0123456789abcdef0123456789")
+	keyring.Current = "k3"
+
+	if result := gorm.ReencryptInBatches(DB.Table("encrypted_user_archives").Where("name <> ?", "encrypted_3"), &[]EncryptedUser{}, 1); result.Error != nil || result.RowsAffected != 2 {
+		t.Fatalf("failed to re-encrypt the specified table, affected %v, got error %v", result.RowsAffected, result.Error)
+	}
+	assertKeyID("k2")
+
+	var archives []struct {
+		Name string
+		SSN  string
+	}
+	DB.Table("encrypted_user_archives").Order("id").Find(&archives)
+	for _, archive := range archives {
+		keyID := "k3"
+		if archive.Name == "encrypted_3" {
+			keyID = "k2"
+		}
+
+		if !strings.HasPrefix(archive.SSN, keyID+":") {
+			t.Errorf("archive %v should be encrypted with key %v, got %+v", archive.Name, keyID, archive)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package tests_test
+
+import (
+	"context"
+	"testing"
+
+	"gorm.io/gorm"
+	. "gorm.io/gorm/utils/tests"
+)
+
+func TestSetOperations(t *testing.T) {
+	users := []User{
+		*GetUser("set_operation_1", Config{}),
+		*GetUser("set_operation_2", Config{}),
+		*GetUser("set_operation_3", Config{}),
+	}
+	users[0].Age, users[1].Age, users[2].Age = 10, 20, 30
+	DB.Create(&users)
+
+	var results []User
+	if err := DB.Model(&User{}).Where("name = ?", "set_operation_3").
+		Union(DB.Model(&User{}).Where("name LIKE ? AND age < ?", "set_operation_%", 25)).
+		Order("age DESC").Limit(2).Find(&results).Error; err != nil {
+		t.Fatalf("failed to query with union, got error: %v", err)
+	}
+
+	if len(results) != 2 || results[0].Name != "set_operation_3" || results[1].Name != "set_operation_2" {
+		t.Errorf("invalid results with union, got %+v", results)
+	}
+
+	var names []string
+	if err := DB.Model(&User{}).Select("name").Where("name LIKE ?", "set_operation_%").
+		UnionAll("SELECT name FROM users WHERE name = ?", "set_operation_1").Order("name").Pluck("name", &names).Error; err != nil {
+		t.Fatalf("failed to query with union all, got error: %v", err)
+	}
+
+	if len(names) != 4 || names[0] != "set_operation_1" || names[1] != "set_operation_1" {
+		t.Errorf("invalid results with union all, got %+v", names)
+	}
+
+	results = nil
+	if err := DB.Model(&User{}).Where("name LIKE ?", "set_operation_%").
+		Except(DB.Model(&User{}).Where("age > ?", 15)).Find(&results).Error; err != nil {
+		t.Fatalf("failed to query with except, got error: %v", err)
+	}
+
+	if len(results) != 1 || results[0].Name != "set_operation_1" {
+		t.Errorf("invalid results with except, got %+v", results)
+	}
+
+	generics, err := gorm.G[User](DB).Where("name LIKE ?", "set_operation_%").
+		Intersect(gorm.G[User](DB).Where("age > ?", 15)).Order("age").Find(context.Background())
+	if err != nil || len(generics) != 2 || generics[0].Name != "set_operation_2" || generics[1].Name != "set_operation_3" {
+		t.Errorf("failed to query with intersect using generics, got %+v, error: %v", generics, err)
+	}
+}
+
+func TestSetOperationsToSQL(t *testing.T) {
+	sql := DB.ToSQL(func(tx *gorm.DB) *gorm.DB {
+		return tx.Model(&User{}).Where("age < ?", 18).Union(DB.Model(&User{}).Where("age > ?", 60)).Order("name").Limit(10).Find(&[]User{})
+	})
+	assertEqualSQL(t, `SELECT * FROM "users" WHERE age < 18 AND "users"."deleted_at" IS NULL UNION SELECT * FROM (SELECT * FROM "users" WHERE age > 60 AND "users"."deleted_at" IS NULL) AS "set_operation_1" ORDER BY name LIMIT 10`, sql)
+
+	sql = DB.ToSQL(func(tx *gorm.DB) *gorm.DB {
+		return tx.Model(&User{}).Where("age < ?", 18).Union(DB.Model(&User{}).Where("age > ?", 60).Order("age").Limit(1)).First(&User{})
+	})
+	assertEqualSQL(t, `SELECT * FROM "users" WHERE age < 18 AND "users"."deleted_at" IS NULL UNION SELECT * FROM (SELECT * FROM "users" WHERE age > 60 AND "users"."deleted_at" IS NULL ORDER BY age LIMIT 1) AS "set_operation_1" ORDER BY "id" LIMIT 1`, sql)
+}
+
+func TestSetOperationsFirstAndCount(t *testing.T) {
+	users := []User{
+		*GetUser("set_operation_count_1", Config{}),
+		*GetUser("set_operation_count_2", Config{}),
+		*GetUser("set_operation_count_3", Config{}),
+	}
+	users[0].Age, users[1].Age, users[2].Age = 10, 20, 30
+	DB.Create(&users)
+
+	query := DB.Model(&User{}).Where("name = ?", "set_operation_count_3").
+		Union(DB.Model(&User{}).Where("name LIKE ?", "set_operation_count_%").Order("age").Limit(2)).
+		Session(&gorm.Session{})
+
+	var count int64
+	if err := query.Count(&count).Error; err != nil || count != 3 {
+		t.Errorf("should count the rows of the compound result, got %v, error: %v", count, err)
+	}
+
+	var first User
+	if err := query.First(&first).Error; err != nil || first.ID != users[0].ID {
+		t.Errorf("should find the first record of the compound result, got %+v, error: %v", first, err)
+	}
+
+	var last User
+	if err := query.Last(&last).Error; err != nil || last.ID != users[2].ID {
+		t.Errorf("should find the last record of the compound result, got %+v, error: %v", last, err)
+	}
+}
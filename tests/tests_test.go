@@ -14,6 +14,7 @@ import (
 	"gorm.io/driver/sqlite"
 	"gorm.io/driver/sqlserver"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"gorm.io/gorm/logger"
 	. "gorm.io/gorm/utils/tests"
 )
@@ -50,7 +51,10 @@ func init() {
 }
 
 func OpenTestConnection(cfg *gorm.Config) (db *gorm.DB, err error) {
-	dbDSN := os.Getenv("GORM_DSN")
+	var (
+		dbDSN    = os.Getenv("GORM_DSN")
+		builders map[string]clause.ClauseBuilder
+	)
 	switch os.Getenv("GORM_DIALECT") {
 	case "mysql":
 		log.Println("testing mysql...")
@@ -58,6 +62,7 @@ func OpenTestConnection(cfg *gorm.Config) (db *gorm.DB, err error) {
 			dbDSN = mysqlDSN
 		}
 		db, err = gorm.Open(mysql.Open(dbDSN), cfg)
+		builders = gorm.MySQLClauseBuilders
 	case "postgres":
 		log.Println("testing postgres...")
 		if dbDSN == "" {
@@ -67,6 +72,7 @@ func OpenTestConnection(cfg *gorm.Config) (db *gorm.DB, err error) {
 			DSN:                  dbDSN,
 			PreferSimpleProtocol: true,
 		}), cfg)
+		builders = gorm.PostgresClauseBuilders
 	case "gaussdb":
 		log.Println("testing gaussdb...")
 		if dbDSN == "" {
@@ -76,6 +82,7 @@ func OpenTestConnection(cfg *gorm.Config) (db *gorm.DB, err error) {
 			DSN:                  dbDSN,
 			PreferSimpleProtocol: true,
 		}), cfg)
+		builders = gorm.PostgresClauseBuilders
 	case "sqlserver":
 		// go install github.com/microsoft/go-sqlcmd/cmd/sqlcmd@latest
 		// SQLCMDPASSWORD=LoremIpsum86 sqlcmd -U sa -S localhost:9930
@@ -90,15 +97,18 @@ func OpenTestConnection(cfg *gorm.Config) (db *gorm.DB, err error) {
 			dbDSN = sqlserverDSN
 		}
 		db, err = gorm.Open(sqlserver.Open(dbDSN), cfg)
+		builders = gorm.SQLServerClauseBuilders
 	case "tidb":
 		log.Println("testing tidb...")
 		if dbDSN == "" {
 			dbDSN = tidbDSN
 		}
 		db, err = gorm.Open(mysql.Open(dbDSN), cfg)
+		builders = gorm.MySQLClauseBuilders
 	default:
 		log.Println("testing sqlite3...")
 		db, err = gorm.Open(sqlite.Open(filepath.Join(os.TempDir(), "gorm.db")), cfg)
+		builders = gorm.SQLiteClauseBuilders
 		if err == nil {
 			db.Exec("PRAGMA foreign_keys = ON")
 		}
@@ -107,6 +117,7 @@ func OpenTestConnection(cfg *gorm.Config) (db *gorm.DB, err error) {
 	if err != nil {
 		return
 	}
+	gorm.RegisterClauseBuilders(db, builders)
 
 	if debug := os.Getenv("DEBUG"); debug == "true" {
 		db.Logger = db.Logger.LogMode(logger.Info)
@@ -1,14 +1,18 @@
 package tests_test
 
 import (
+	"context"
 	"errors"
+	"reflect"
 	"regexp"
 	"sort"
 	"strings"
 	"testing"
 	"time"
 
+	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
+	"gorm.io/gorm/callbacks"
 	"gorm.io/gorm/clause"
 	"gorm.io/gorm/utils"
 	. "gorm.io/gorm/utils/tests"
@@ -931,3 +935,237 @@ func TestUpdateFrom(t *testing.T) {
 		}
 	}
 }
+
+func TestUpdateWithJoins(t *testing.T) {
+	users := []*User{
+		GetUser("update-joins-1", Config{Account: true}),
+		GetUser("update-joins-2", Config{Account: true}),
+		GetUser("update-joins-3", Config{Account: true}),
+	}
+
+	if err := DB.Create(&users).Error; err != nil {
+		t.Fatalf("errors happened when create: %v", err)
+	}
+
+	if result := DB.Model(&User{}).Joins("Account").Where("Account.number = ?", users[0].Account.Number).Update("name", "update-joins-franco"); result.Error != nil || result.RowsAffected != 1 {
+		t.Errorf("should only update one record, but got %v, error: %v", result.RowsAffected, result.Error)
+	}
+
+	var result User
+	if err := DB.First(&result, users[0].ID).Error; err != nil || result.Name != "update-joins-franco" {
+		t.Errorf("user's name should be updated, got %v, error: %v", result.Name, err)
+	} else if result.UpdatedAt.UnixNano() == users[0].UpdatedAt.UnixNano() {
+		t.Errorf("user's updated at should be changed, but got %v, was %v", result.UpdatedAt, users[0].UpdatedAt)
+	}
+
+	numbers := []string{users[1].Account.Number, users[2].Account.Number}
+	if result := DB.Model(&User{}).Joins("JOIN accounts ON accounts.user_id = users.id AND accounts.number IN ?", numbers).
+		Update("name", gorm.Expr("(SELECT number FROM accounts WHERE accounts.user_id = users.id)")); result.Error != nil || result.RowsAffected != 2 {
+		t.Errorf("should update two records, but got %v, error: %v", result.RowsAffected, result.Error)
+	}
+
+	var results []User
+	DB.Preload("Account").Find(&results, []uint{users[1].ID, users[2].ID})
+	for _, user := range results {
+		if user.Name != user.Account.Number {
+			t.Errorf("user's name should be equal to the account's number %v, but got %v", user.Account.Number, user.Name)
+		}
+	}
+
+	if err := DB.Model(&User{}).Joins("LEFT JOIN accounts ON accounts.user_id = users.id").Update("name", "update-joins").Error; !errors.Is(err, gorm.ErrMissingWhereClause) {
+		t.Errorf("should returns missing where clause error for left join, but got %v", err)
+	}
+
+	if result := DB.Model(&User{}).Joins("Account").Where("Account.number IS NULL AND users.name LIKE ?", "update-joins-%").Update("age", 99); result.Error != nil || result.RowsAffected != 0 {
+		t.Errorf("left join of relation shouldn't be turned into inner join, got %v, error: %v", result.RowsAffected, result.Error)
+	}
+}
+
+type joinStyleDialector struct {
+	DummyDialector
+	style callbacks.JoinStyle
+}
+
+func (dialector joinStyleDialector) Initialize(db *gorm.DB) error {
+	callbacks.RegisterDefaultCallbacks(db, &callbacks.Config{UpdateJoinStyle: dialector.style, DeleteJoinStyle: dialector.style})
+	return nil
+}
+
+func TestUpdateWithJoinsToSQL(t *testing.T) {
+	db, _ := gorm.Open(DummyDialector{}, nil)
+	sql := db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+		return tx.Model(&User{}).InnerJoins("Account").Where("Account.number = ?", "1").UpdateColumn("name", "jinzhu")
+	})
+	assertEqualSQL(t, "UPDATE `users` SET `name`=\"jinzhu\" WHERE `users`.`id` IN (SELECT * FROM (SELECT `users`.`id` FROM `users` INNER JOIN `accounts` `Account` ON `users`.`id` = `Account`.`user_id` AND `Account`.`deleted_at` IS NULL WHERE Account.number = \"1\" AND `users`.`deleted_at` IS NULL) AS `joined_users`)", sql)
+
+	db, _ = gorm.Open(joinStyleDialector{style: callbacks.MultiTableJoinStyle}, nil)
+	sql = db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+		return tx.Model(&User{}).Joins("Account").Where("Account.number = ?", "1").UpdateColumn("name", "jinzhu")
+	})
+	assertEqualSQL(t, "UPDATE `users` LEFT JOIN `accounts` `Account` ON `users`.`id` = `Account`.`user_id` AND `Account`.`deleted_at` IS NULL SET `users`.`name`=\"jinzhu\" WHERE Account.number = \"1\" AND `users`.`deleted_at` IS NULL", sql)
+
+	db, _ = gorm.Open(joinStyleDialector{style: callbacks.FromJoinStyle}, nil)
+	sql = db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+		return tx.Model(&User{}).Joins("JOIN accounts ON accounts.user_id = users.id AND accounts.number = ?", "1").UpdateColumn("name", gorm.Expr("accounts.number"))
+	})
+	assertEqualSQL(t, "UPDATE `users` SET `name`=accounts.number FROM accounts WHERE `users`.`deleted_at` IS NULL AND (accounts.user_id = users.id AND accounts.number = \"1\")", sql)
+
+	// left joins couldn't be listed in the FROM clause
+	sql = db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+		return tx.Model(&User{}).Joins("Account").Where("Account.number IS NULL").UpdateColumn("name", "jinzhu")
+	})
+	assertEqualSQL(t, "UPDATE `users` SET `name`=\"jinzhu\" WHERE `users`.`id` IN (SELECT * FROM (SELECT `users`.`id` FROM `users` LEFT JOIN `accounts` `Account` ON `users`.`id` = `Account`.`user_id` AND `Account`.`deleted_at` IS NULL WHERE Account.number IS NULL AND `users`.`deleted_at` IS NULL) AS `joined_users`)", sql)
+}
+
+// fromJoinPostgresDialector updates from the joined tables or rows of batches like the dialects supporting `UPDATE ... FROM`
+type fromJoinPostgresDialector struct {
+	gorm.Dialector
+}
+
+func (dialector fromJoinPostgresDialector) Initialize(db *gorm.DB) error {
+	if err := dialector.Dialector.Initialize(db); err != nil {
+		return err
+	}
+
+	return db.Callback().Update().Replace("gorm:update", callbacks.Update(&callbacks.Config{
+		UpdateClauses:   []string{"UPDATE", "SET", "FROM", "WHERE"},
+		UpdateJoinStyle: callbacks.FromJoinStyle,
+	}))
+}
+
+func TestUpdatesInBatches(t *testing.T) {
+	users := []User{
+		*GetUser("updates_in_batches_1", Config{}),
+		*GetUser("updates_in_batches_2", Config{}),
+		*GetUser("updates_in_batches_3", Config{}),
+		*GetUser("updates_in_batches_4", Config{}),
+		*GetUser("updates_in_batches_5", Config{}),
+	}
+
+	if err := DB.Create(&users).Error; err != nil {
+		t.Fatalf("errors happened when create: %v", err)
+	}
+
+	lastUpdatedAt := users[0].UpdatedAt
+	for idx := range users {
+		users[idx].Name += "_new"
+		users[idx].Age = uint(idx + 30)
+		users[idx].Active = idx%2 == 0
+	}
+
+	if result := DB.UpdatesInBatches(&users, 2); result.Error != nil || result.RowsAffected != 5 {
+		t.Fatalf("failed to update in batches, affected %v, got error: %v", result.RowsAffected, result.Error)
+	}
+
+	var results []User
+	DB.Order("id").Find(&results, "name LIKE ?", "updates_in_batches_%")
+	if len(results) != 5 {
+		t.Fatalf("invalid results, got %+v", results)
+	}
+
+	for idx, result := range results {
+		if result.Name != users[idx].Name || result.Age != users[idx].Age || result.Active != users[idx].Active {
+			t.Errorf("user #%v should be updated, expects %+v, got %+v", idx, users[idx], result)
+		}
+
+		if !result.UpdatedAt.After(lastUpdatedAt) || !users[idx].UpdatedAt.Equal(result.UpdatedAt) {
+			t.Errorf("user #%v updated at should be changed, got %v, was %v", idx, result.UpdatedAt, lastUpdatedAt)
+		}
+	}
+
+	for idx := range users {
+		users[idx].Name = "updates_in_batches_omit"
+		users[idx].Age = uint(idx + 40)
+	}
+
+	if err := DB.Omit("Name").UpdatesInBatches(users, 10).Error; err != nil {
+		t.Fatalf("failed to update in batches with omit, got error: %v", err)
+	}
+
+	DB.Order("id").Find(&results, "name LIKE ?", "updates_in_batches_%")
+	for idx, result := range results {
+		if result.Name == "updates_in_batches_omit" || result.Age != uint(idx+40) {
+			t.Errorf("user #%v should be updated except name, got %+v", idx, result)
+		}
+	}
+
+	if err := DB.UpdatesInBatches(&[]User{{Name: "updates_in_batches"}}, 10).Error; !errors.Is(err, gorm.ErrPrimaryKeyRequired) {
+		t.Errorf("should returns error for missing primary key, but got %v", err)
+	}
+
+	if err := DB.UpdatesInBatches(&[]*User{&users[0], nil}, 10).Error; !errors.Is(err, gorm.ErrInvalidValue) {
+		t.Errorf("should returns error for nil element, but got %v", err)
+	}
+
+	for idx := range results {
+		results[idx].Age = uint(idx + 50)
+	}
+	if rows, err := gorm.G[User](DB).Select("Age").UpdatesInBatches(context.Background(), &results, 3); err != nil || rows != 5 {
+		t.Errorf("failed to update in batches with generics, affected %v, got error: %v", rows, err)
+	}
+
+	var ages []uint
+	DB.Model(&User{}).Where("name LIKE ?", "updates_in_batches_%").Order("id").Pluck("age", &ages)
+	if !reflect.DeepEqual(ages, []uint{50, 51, 52, 53, 54}) {
+		t.Errorf("invalid ages, got %v", ages)
+	}
+}
+
+func TestUpdatesInBatchesToSQL(t *testing.T) {
+	users := []User{{Model: gorm.Model{ID: 1}, Name: "jinzhu", Age: 18}, {Model: gorm.Model{ID: 2}, Name: "josh", Age: 20}}
+
+	updatesInBatchesSQL := func(dialector gorm.Dialector, fc func(tx *gorm.DB) *gorm.DB) (sqls []string) {
+		db, _ := gorm.Open(dialector, &gorm.Config{DryRun: true, DisableAutomaticPing: true, SkipDefaultTransaction: true})
+		db.Callback().Update().After("gorm:update").Register("test:updates_in_batches_sql", func(db *gorm.DB) {
+			sqls = append(sqls, db.Dialector.Explain(db.Statement.SQL.String(), db.Statement.Vars...))
+		})
+		if err := fc(db).Error; err != nil {
+			t.Errorf("failed to update in batches, got error %v", err)
+		}
+		return
+	}
+
+	sqls := updatesInBatchesSQL(DummyDialector{}, func(tx *gorm.DB) *gorm.DB {
+		return tx.Select("Name", "Age").UpdatesInBatches(&users, 10)
+	})
+	if len(sqls) != 1 || !regexp.MustCompile("UPDATE `users` SET `updated_at`=.*,`name`=CASE WHEN `users`.`id` = 1 THEN \"jinzhu\" WHEN `users`.`id` = 2 THEN \"josh\" ELSE `users`.`name` END,`age`=CASE WHEN `users`.`id` = 1 THEN 18 WHEN `users`.`id` = 2 THEN 20 ELSE `users`.`age` END WHERE `users`.`deleted_at` IS NULL AND `users`.`id` IN \\(1,2\\)").MatchString(sqls[0]) {
+		t.Errorf("invalid sql of updating in batches, got %v", sqls)
+	}
+
+	// the dialects update from other tables, the rows are listed in VALUES
+	sqls = updatesInBatchesSQL(joinStyleDialector{style: callbacks.FromJoinStyle}, func(tx *gorm.DB) *gorm.DB {
+		return tx.Select("Name").Omit("UpdatedAt").UpdatesInBatches(&users, 10)
+	})
+	if len(sqls) != 1 {
+		t.Fatalf("invalid sqls of updating in batches, got %v", sqls)
+	}
+	assertEqualSQL(t, "UPDATE `users` SET `name`=`batch_rows`.`name` FROM (VALUES (1,\"jinzhu\"),(2,\"josh\")) AS `batch_rows`(`id`,`name`) WHERE `users`.`deleted_at` IS NULL AND `users`.`id` = `batch_rows`.`id`", sqls[0])
+
+	// the values of the first row are casted to the column types
+	sqls = updatesInBatchesSQL(fromJoinPostgresDialector{postgres.New(postgres.Config{DSN: postgresDSN})}, func(tx *gorm.DB) *gorm.DB {
+		return tx.Select("Name", "Age").Omit("UpdatedAt").UpdatesInBatches(&users, 10)
+	})
+	if len(sqls) != 1 {
+		t.Fatalf("invalid sqls of updating in batches, got %v", sqls)
+	}
+	assertEqualSQL(t, `UPDATE "users" SET "name"="batch_rows"."name","age"="batch_rows"."age" FROM (VALUES (CAST(1 AS bigint),CAST('jinzhu' AS text),CAST(18 AS bigint)),(2,'josh',20)) AS "batch_rows"("id","name","age") WHERE "users"."deleted_at" IS NULL AND "users"."id" = "batch_rows"."id"`, sqls[0])
+
+	db, _ := gorm.Open(DummyDialector{}, &gorm.Config{DryRun: true})
+	if err := db.UpdatesInBatches(&users, 0).Error; !errors.Is(err, gorm.ErrInvalidData) {
+		t.Errorf("should returns error for invalid batch size, got error %v", err)
+	}
+
+	// arrays are updated in batches like slices
+	sqls = updatesInBatchesSQL(DummyDialector{}, func(tx *gorm.DB) *gorm.DB {
+		return tx.Select("Name").Omit("UpdatedAt").UpdatesInBatches([2]User{users[0], users[1]}, 1)
+	})
+	if len(sqls) != 2 {
+		t.Errorf("invalid sqls of updating array in batches, got %v", sqls)
+	}
+
+	// updating with the slice is not updating in batches
+	db, _ = gorm.Open(DummyDialector{}, &gorm.Config{DryRun: true})
+	if err := db.Model(&users).Select("Name").Updates(&users).Error; !errors.Is(err, gorm.ErrInvalidData) {
+		t.Errorf("updates with the slice should not update in batches, got error %v", err)
+	}
+}
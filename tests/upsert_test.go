@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 	. "gorm.io/gorm/utils/tests"
@@ -88,6 +89,61 @@ func TestUpsert(t *testing.T) {
 	}
 }
 
+func TestUpsertWithExcluded(t *testing.T) {
+	user := *GetUser("upsert_excluded", Config{})
+	user.Age = 20
+	if err := DB.Create(&user).Error; err != nil {
+		t.Fatalf("failed to create user, got error %v", err)
+	}
+
+	onConflict := clause.OnConflict{
+		Columns:   []clause.Column{{Name: "id"}},
+		DoUpdates: clause.Set{clause.AddExcluded("age"), clause.GreatestExcluded("name"), clause.CoalesceExcluded("birthday"), clause.GreatestExcluded("manager_id")},
+	}
+
+	user2 := User{Model: gorm.Model{ID: user.ID}, Name: "upsert_excluded_new", Age: 10, ManagerID: &user.ID}
+	if err := DB.Clauses(onConflict).Create(&user2).Error; err != nil {
+		t.Fatalf("failed to upsert, got error %v", err)
+	}
+
+	var result User
+	if err := DB.First(&result, user.ID).Error; err != nil {
+		t.Fatalf("failed to find user, got error %v", err)
+	}
+
+	if result.Age != 30 || result.Name != "upsert_excluded_new" || result.Birthday == nil || !result.Birthday.Equal(*user.Birthday) ||
+		result.ManagerID == nil || *result.ManagerID != user.ID {
+		t.Errorf("invalid upserted user, got %+v", result)
+	}
+
+	user3 := User{Model: gorm.Model{ID: user.ID}, Name: "upsert_excluded", Age: 5}
+	if err := DB.Clauses(onConflict).Create(&user3).Error; err != nil {
+		t.Fatalf("failed to upsert, got error %v", err)
+	}
+
+	if err := DB.First(&result, user.ID).Error; err != nil || result.Age != 35 || result.Name != "upsert_excluded_new" {
+		t.Errorf("invalid upserted user, got %+v, error: %v", result, err)
+	}
+}
+
+func TestUpsertWithExcludedToSQL(t *testing.T) {
+	db, err := gorm.Open(mysql.New(mysql.Config{DSN: mysqlDSN, SkipInitializeWithVersion: true}), &gorm.Config{DisableAutomaticPing: true})
+	if err != nil {
+		t.Fatalf("failed to open mysql dialector, got error %v", err)
+	}
+	gorm.RegisterClauseBuilders(db, gorm.MySQLClauseBuilders)
+
+	sql := db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+		return tx.Clauses(clause.OnConflict{
+			DoUpdates: clause.Set{clause.AddExcluded("age"), clause.CoalesceExcluded("birthday"), {Column: clause.Column{Name: "name"}, Value: clause.Excluded("name")}},
+		}).Omit(clause.Associations).Create(&User{Name: "jinzhu", Age: 18})
+	})
+
+	if !regexp.MustCompile("ON DUPLICATE KEY UPDATE `age`=`users`.`age` \\+ VALUES\\(`age`\\),`birthday`=COALESCE\\(VALUES\\(`birthday`\\), `users`.`birthday`\\),`name`=VALUES\\(`name`\\)$").MatchString(sql) {
+		t.Errorf("invalid upsert sql with excluded for mysql, got %v", sql)
+	}
+}
+
 func TestUpsertSlice(t *testing.T) {
 	langs := []Language{
 		{Code: "upsert-slice1", Name: "Upsert-slice1"},
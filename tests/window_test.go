@@ -0,0 +1,78 @@
+package tests_test
+
+import (
+	"context"
+	"testing"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	. "gorm.io/gorm/utils/tests"
+)
+
+func TestWindowFunctions(t *testing.T) {
+	users := []User{
+		*GetUser("window_1", Config{}),
+		*GetUser("window_2", Config{}),
+		*GetUser("window_3", Config{}),
+		*GetUser("window_4", Config{}),
+	}
+	users[0].Age, users[1].Age, users[2].Age, users[3].Age = 20, 30, 20, 30
+	DB.Create(&users)
+
+	type result struct {
+		Name  string
+		Rn    int
+		Total int
+	}
+
+	rowNumber := clause.Over{
+		Function: clause.Expr{SQL: "ROW_NUMBER()"},
+		Window: clause.Window{
+			PartitionBy: []clause.Column{{Name: "age"}},
+			OrderBy:     []clause.OrderByColumn{{Column: clause.Column{Name: "name"}, Desc: true}},
+		},
+		Alias: "rn",
+	}
+
+	var results []result
+	if err := DB.Model(&User{}).Select("name, ?", rowNumber).Where("name LIKE ?", "window_%").Order("name").Find(&results).Error; err != nil {
+		t.Fatalf("failed to query with window function, got error: %v", err)
+	}
+
+	if len(results) != 4 || results[0].Rn != 2 || results[1].Rn != 2 || results[2].Rn != 1 || results[3].Rn != 1 {
+		t.Errorf("invalid results with window function, got %+v", results)
+	}
+
+	results = nil
+	if err := DB.Model(&User{}).Select("name, ?", clause.Over{
+		Function: clause.Expr{SQL: "SUM(?)", Vars: []interface{}{clause.Column{Name: "age"}}}, WindowName: "w", Alias: "total",
+	}).Clauses(clause.Windows{{
+		Name:    "w",
+		OrderBy: []clause.OrderByColumn{{Column: clause.Column{Name: "name"}}},
+		Frame:   &clause.Frame{Start: clause.UnboundedPreceding, End: clause.CurrentRow},
+	}}).Where("name LIKE ?", "window_%").Order("name").Find(&results).Error; err != nil {
+		t.Fatalf("failed to query with named window, got error: %v", err)
+	}
+
+	if len(results) != 4 || results[0].Total != 20 || results[1].Total != 50 || results[2].Total != 70 || results[3].Total != 100 {
+		t.Errorf("invalid results with named window, got %+v", results)
+	}
+
+	ordered, err := gorm.G[User](DB).Where("name LIKE ?", "window_%").Order(clause.Over{
+		Function: clause.Expr{SQL: "ROW_NUMBER()"},
+		Window:   clause.Window{PartitionBy: []clause.Column{{Name: "age"}}, OrderBy: []clause.OrderByColumn{{Column: clause.Column{Name: "name"}}}},
+	}).Order("age DESC").Find(context.Background())
+	if err != nil || len(ordered) != 4 || ordered[0].Name != "window_2" || ordered[1].Name != "window_1" || ordered[2].Name != "window_4" {
+		t.Errorf("failed to order by window function using generics, got %+v, error: %v", ordered, err)
+	}
+}
+
+func TestWindowToSQL(t *testing.T) {
+	sql := DB.ToSQL(func(tx *gorm.DB) *gorm.DB {
+		return tx.Model(&User{}).Select("name, ?", clause.Over{
+			Function: clause.Expr{SQL: "RANK()"}, WindowName: "w", Alias: "age_rank",
+		}).Clauses(clause.Windows{{Name: "w", OrderBy: []clause.OrderByColumn{{Column: clause.Column{Name: "age"}, Desc: true}}}}).
+			Order(clause.OrderByColumn{Expression: clause.Expr{SQL: "RANK() OVER ?", Vars: []interface{}{clause.Column{Name: "w", Raw: true}}}}).Find(&[]User{})
+	})
+	assertEqualSQL(t, `SELECT name, RANK() OVER "w" AS "age_rank" FROM "users" WHERE "users"."deleted_at" IS NULL WINDOW "w" AS (ORDER BY "age" DESC) ORDER BY RANK() OVER w`, sql)
+}
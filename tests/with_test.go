@@ -0,0 +1,97 @@
+package tests_test
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"gorm.io/gorm"
+	. "gorm.io/gorm/utils/tests"
+)
+
+func TestWith(t *testing.T) {
+	users := []User{
+		*GetUser("with_1", Config{}),
+		*GetUser("with_2", Config{}),
+		*GetUser("with_3", Config{}),
+	}
+	users[1].Age = 30
+	users[2].Age = 40
+	DB.Create(&users)
+
+	var results []User
+	if err := DB.With("with_users", DB.Model(&User{}).Where("name LIKE ? AND age > ?", "with_%", 20)).
+		Table("with_users").Order("id").Find(&results).Error; err != nil {
+		t.Fatalf("failed to query with CTE, got error: %v", err)
+	}
+
+	if len(results) != 2 || results[0].Name != "with_2" || results[1].Name != "with_3" {
+		t.Errorf("invalid results from CTE, got %+v", results)
+	}
+
+	var count int64
+	if err := DB.With("with_users(id, name)", "SELECT id, name FROM users WHERE name LIKE ?", "with_%").
+		Table("with_users").Count(&count).Error; err != nil || count != 3 {
+		t.Errorf("failed to count with CTE, count %v, got error: %v", count, err)
+	}
+
+	if err := DB.With("with_old", DB.Model(&User{}).Select("id").Where("name LIKE ? AND age > ?", "with_%", 35)).
+		Model(&User{}).Where("id IN (?)", DB.Table("with_old").Select("id")).Update("active", true).Error; err != nil {
+		t.Fatalf("failed to update with CTE, got error: %v", err)
+	}
+
+	var active []User
+	DB.Where("name LIKE ? AND active = ?", "with_%", true).Find(&active)
+	if len(active) != 1 || active[0].Name != "with_3" {
+		t.Errorf("invalid updated results with CTE, got %+v", active)
+	}
+
+	if res := DB.With("with_young", DB.Model(&User{}).Select("id").Where("name LIKE ? AND age < ?", "with_%", 20)).
+		Where("id IN (?)", DB.Table("with_young").Select("id")).Delete(&User{}); res.Error != nil || res.RowsAffected != 1 {
+		t.Fatalf("failed to delete with CTE, affected %v, got error: %v", res.RowsAffected, res.Error)
+	}
+
+	generics, err := gorm.G[User](DB).With("with_users", gorm.G[User](DB).Where("name LIKE ?", "with_%")).
+		Table("with_users").Order("age").Find(context.Background())
+	if err != nil || len(generics) != 2 || generics[0].Name != "with_2" {
+		t.Errorf("failed to find with CTE using generics, got %+v, error: %v", generics, err)
+	}
+}
+
+func TestWithRecursive(t *testing.T) {
+	manager := GetUser("with_recursive_manager", Config{Team: 2})
+	DB.Create(manager)
+
+	member := manager.Team[0]
+	member.Team = []User{*GetUser("with_recursive_member", Config{})}
+	DB.Save(&member)
+
+	type node struct {
+		ID    uint
+		Name  string
+		Level int
+	}
+
+	var nodes []node
+	if err := DB.WithRecursive("org(id, name, level)", "SELECT id, name, 0 FROM users WHERE id = ? UNION ALL "+
+		"SELECT users.id, users.name, org.level + 1 FROM users JOIN org ON users.manager_id = org.id", manager.ID).
+		Table("org").Order("level").Find(&nodes).Error; err != nil {
+		t.Fatalf("failed to query with recursive CTE, got error: %v", err)
+	}
+
+	if len(nodes) != 4 {
+		t.Fatalf("invalid results, got %+v", nodes)
+	}
+
+	sort.SliceStable(nodes, func(i, j int) bool { return nodes[i].Level < nodes[j].Level })
+	if nodes[0].Name != manager.Name || nodes[1].Level != 1 || nodes[2].Level != 1 || nodes[3].Name != "with_recursive_member" || nodes[3].Level != 2 {
+		t.Errorf("invalid results, got %+v", nodes)
+	}
+}
+
+func TestWithToSQL(t *testing.T) {
+	sql := DB.ToSQL(func(tx *gorm.DB) *gorm.DB {
+		return tx.With("young_users", DB.Model(&User{}).Where("age < ?", 18)).Table("young_users").Find(&[]User{})
+	})
+	assertEqualSQL(t, `WITH "young_users" AS (SELECT * FROM "users" WHERE age < 18 AND "users"."deleted_at" IS NULL) SELECT * FROM "young_users" WHERE "young_users"."deleted_at" IS NULL`, sql)
+}